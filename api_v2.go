@@ -0,0 +1,549 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const api_v2_prefix = "/api/v2"
+
+// apiError 是 v2 接口统一的错误格式
+type apiError struct {
+	Code    string       `json:"code"`
+	Message string       `json:"message"`
+	Errors  []fieldError `json:"errors,omitempty"`
+}
+
+func writeJSON(w http.ResponseWriter, status int, value interface{}) {
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	w.WriteHeader(status)
+	if nil != value {
+		json.NewEncoder(w).Encode(value)
+	}
+}
+
+func writeAPIError(w http.ResponseWriter, status int, code, message string) {
+	writeJSON(w, status, map[string]interface{}{"error": &apiError{Code: code, Message: message}})
+}
+
+func writeAPIErrorWithStatus(w http.ResponseWriter, status int, e error) {
+	switch status {
+	case http.StatusBadRequest:
+		writeAPIError(w, status, "bad_request", e.Error())
+	case http.StatusUnauthorized:
+		writeAPIError(w, status, "unauthorized", e.Error())
+	case http.StatusForbidden:
+		writeAPIError(w, status, "forbidden", e.Error())
+	case http.StatusNotFound:
+		writeAPIError(w, status, "not_found", e.Error())
+	case http.StatusMethodNotAllowed:
+		writeAPIError(w, status, "method_not_allowed", e.Error())
+	case http.StatusConflict:
+		writeAPIError(w, status, "conflict", e.Error())
+	case http.StatusUnprocessableEntity:
+		writeAPIError(w, status, "unprocessable_entity", e.Error())
+	case http.StatusNotImplemented:
+		writeAPIError(w, status, "not_implemented", e.Error())
+	default:
+		writeAPIError(w, status, "internal_error", e.Error())
+	}
+}
+
+var (
+	errJobNotFound      = errors.New("job isn't found")
+	errMethodNotAllowed = errors.New("method isn't allowed")
+	errRouteNotFound    = errors.New("route isn't found")
+)
+
+func isAPIv2(pa string) bool {
+	return api_v2_prefix == pa || strings.HasPrefix(pa, api_v2_prefix+"/")
+}
+
+func writeAPIAuthError(w http.ResponseWriter, e error) int {
+	if errForbidden == e {
+		writeAPIErrorWithStatus(w, http.StatusForbidden, e)
+		return http.StatusForbidden
+	}
+	w.Header().Set("WWW-Authenticate", `Basic realm="`+*auth_realm+`", charset="UTF-8"`)
+	writeAPIErrorWithStatus(w, http.StatusUnauthorized, e)
+	return http.StatusUnauthorized
+}
+
+// splitAPIPath 将 /api/v2/jobs/12/retry 拆分为 ["jobs", "12", "retry"]
+func splitAPIPath(pa string) []string {
+	pa = strings.Trim(strings.TrimPrefix(pa, api_v2_prefix), "/")
+	if "" == pa {
+		return nil
+	}
+	return strings.Split(pa, "/")
+}
+
+func apiV2Handler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	ss := splitAPIPath(r.URL.Path)
+	if 0 == len(ss) {
+		writeAPIErrorWithStatus(w, http.StatusNotFound, errRouteNotFound)
+		return
+	}
+
+	switch ss[0] {
+	case "jobs":
+		switch len(ss) {
+		case 1:
+			switch r.Method {
+			case "GET", "HEAD":
+				apiListJobs(w, r, backend)
+			case "POST":
+				apiCreateJobs(w, r, backend)
+			default:
+				writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			}
+			return
+		case 2, 3:
+			if 2 == len(ss) && "bulk" == ss[1] {
+				if "POST" != r.Method {
+					writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+					return
+				}
+				apiBulkJobs(w, r, backend)
+				return
+			}
+			if 2 == len(ss) && "preview" == ss[1] {
+				if "POST" != r.Method {
+					writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+					return
+				}
+				apiPreviewJob(w, r, backend)
+				return
+			}
+
+			id, e := strconv.ParseInt(ss[1], 10, 64)
+			if nil != e {
+				writeAPIErrorWithStatus(w, http.StatusNotFound, errors.New("job id '"+ss[1]+"' is invalid"))
+				return
+			}
+
+			if 3 == len(ss) {
+				switch ss[2] {
+				case "retry":
+					if "POST" != r.Method {
+						writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+						return
+					}
+					apiRetryJob(w, r, backend, id)
+					return
+				case "attempts":
+					if "GET" != r.Method && "HEAD" != r.Method {
+						writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+						return
+					}
+					apiJobAttempts(w, r, backend, id)
+					return
+				case "preview":
+					if "GET" != r.Method && "HEAD" != r.Method {
+						writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+						return
+					}
+					apiPreviewSavedJob(w, r, backend, id)
+					return
+				}
+				break
+			}
+
+			switch r.Method {
+			case "GET", "HEAD":
+				apiGetJob(w, r, backend, id)
+			case "PATCH", "PUT":
+				apiEditJob(w, r, backend, id)
+			case "DELETE":
+				apiDeleteJob(w, r, backend, id)
+			default:
+				writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			}
+			return
+		}
+	case "queues":
+		if 3 == len(ss) && ("pause" == ss[2] || "resume" == ss[2]) {
+			if "POST" != r.Method {
+				writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+				return
+			}
+			apiPauseQueue(w, r, backend, ss[1], "pause" == ss[2])
+			return
+		}
+		if 1 != len(ss) {
+			break
+		}
+		if "GET" != r.Method && "HEAD" != r.Method {
+			writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+		apiListQueues(w, r, backend)
+		return
+	case "stats":
+		if 1 != len(ss) {
+			break
+		}
+		if "GET" != r.Method && "HEAD" != r.Method {
+			writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+		apiStats(w, r, backend)
+		return
+	case "handlers":
+		if 1 != len(ss) {
+			break
+		}
+		if "GET" != r.Method && "HEAD" != r.Method {
+			writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+		apiListHandlers(w, r, backend)
+		return
+	case "events":
+		if 1 != len(ss) {
+			break
+		}
+		if "GET" != r.Method {
+			writeAPIErrorWithStatus(w, http.StatusMethodNotAllowed, errMethodNotAllowed)
+			return
+		}
+		eventsHandler(w, r, backend)
+		return
+	}
+	writeAPIErrorWithStatus(w, http.StatusNotFound, errRouteNotFound)
+}
+
+func jobStateParams(state string) (map[string]interface{}, error) {
+	switch state {
+	case "", "all":
+		return map[string]interface{}{}, nil
+	case "failed":
+		return map[string]interface{}{"@failed_at": "[notnull]"}, nil
+	case "queued":
+		return map[string]interface{}{"@failed_at": nil, "@locked_by": nil}, nil
+	case "active":
+		return map[string]interface{}{"@failed_at": nil, "@locked_by": "[notnull]"}, nil
+	default:
+		return nil, errors.New("state '" + state + "' is unsupported, it must is all, failed, queued or active")
+	}
+}
+
+func apiListJobs(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	q, e := parseJobQuery(r.URL.Query(), 100)
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusBadRequest, e)
+		return
+	}
+
+	results, cursor, e := backend.findJobs(q)
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	if nil == results {
+		results = []map[string]interface{}{}
+	}
+	if "" != cursor {
+		w.Header().Set("X-Next-Cursor", cursor)
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func apiGetJob(w http.ResponseWriter, r *http.Request, backend *dbBackend, id int64) {
+	results, e := backend.where(map[string]interface{}{"@id": id})
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	if 0 == len(results) {
+		writeAPIErrorWithStatus(w, http.StatusNotFound, errJobNotFound)
+		return
+	}
+	writeJSON(w, http.StatusOK, results[0])
+}
+
+// createdJob 是 push 后返回给调用者的任务标识
+type createdJob struct {
+	Id        int64  `json:"id"`
+	HandlerId string `json:"handler_id"`
+}
+
+func createdJobs(jobs []*Job) []createdJob {
+	results := make([]createdJob, len(jobs))
+	for i, job := range jobs {
+		results[i] = createdJob{Id: job.id, HandlerId: job.handler_id}
+	}
+	return results
+}
+
+// apiCreateJobs 创建任务， 请求体可以是一个任务或任务的数组
+func apiCreateJobs(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	ctx, span := tracer.Start(extractTraceContextFromRequest(r), "push")
+	defer span.End()
+
+	bs, e := ioutil.ReadAll(r.Body)
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusBadRequest, e)
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	var body interface{}
+	if e := decoder.Decode(&body); nil != e {
+		writeAPIErrorWithStatus(w, http.StatusBadRequest, e)
+		return
+	}
+
+	var entities []map[string]interface{}
+	is_array := false
+	switch v := body.(type) {
+	case map[string]interface{}:
+		entities = []map[string]interface{}{v}
+	case []interface{}:
+		is_array = true
+		for i, o := range v {
+			ent, ok := o.(map[string]interface{})
+			if !ok {
+				writeAPIErrorWithStatus(w, http.StatusUnprocessableEntity, errors.New("data["+strconv.Itoa(i)+"] isn't an object"))
+				return
+			}
+			entities = append(entities, ent)
+		}
+	default:
+		writeAPIErrorWithStatus(w, http.StatusUnprocessableEntity, errors.New("body must is an object or an array"))
+		return
+	}
+
+	jobs := make([]*Job, len(entities))
+	for i, ent := range entities {
+		injectTraceContextToEntity(ctx, ent)
+		job, e := createJobFromMap(backend, ent)
+		if nil != e {
+			if ve, ok := e.(*validationError); ok {
+				if is_array {
+					ve = ve.withPrefix("data[" + strconv.Itoa(i) + "]")
+				}
+				writeValidationError(w, ve)
+				return
+			}
+			if is_array {
+				e = errors.New("parse data[" + strconv.Itoa(i) + "] failed, " + e.Error())
+			}
+			writeAPIErrorWithStatus(w, http.StatusUnprocessableEntity, e)
+			return
+		}
+		jobs[i] = job
+	}
+
+	results, replayed, e := backend.pushJobs(r.Header.Get("Idempotency-Key"), bs, jobs)
+	if nil != e {
+		writeAPIErrorWithStatus(w, pushErrorStatus(e), e)
+		return
+	}
+
+	if replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+	}
+	if is_array {
+		writeJSON(w, http.StatusCreated, results)
+	} else {
+		writeJSON(w, http.StatusCreated, results[0])
+	}
+}
+
+func apiRetryJob(w http.ResponseWriter, r *http.Request, backend *dbBackend, id int64) {
+	before := backend.jobSnapshot(id)
+	if nil == before {
+		writeAPIErrorWithStatus(w, http.StatusNotFound, errJobNotFound)
+		return
+	}
+	if failed, _ := before.(map[string]interface{})["failed"].(bool); !failed {
+		writeAPIErrorWithStatus(w, http.StatusConflict, errors.New("job isn't failed"))
+		return
+	}
+
+	if e := backend.retry(id); nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	after := backend.jobSnapshot(id)
+	backend.audit(r, "retry", id, before, after)
+	publishSnapshotEvent(event_retried, before)
+	writeJSON(w, http.StatusOK, after)
+}
+
+func apiDeleteJob(w http.ResponseWriter, r *http.Request, backend *dbBackend, id int64) {
+	before := backend.jobSnapshot(id)
+	if nil == before {
+		writeAPIErrorWithStatus(w, http.StatusNotFound, errJobNotFound)
+		return
+	}
+	job := before.(map[string]interface{})
+	if _, locked := job["locked_by"]; locked && false == job["failed"] {
+		writeAPIErrorWithStatus(w, http.StatusConflict, errors.New("job is running"))
+		return
+	}
+
+	if e := backend.destroy(id); nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	backend.audit(r, "delete", id, before, nil)
+	publishSnapshotEvent(event_deleted, before)
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// apiListQueues 返回各个队列的任务数、 是否暂停和最近一小时的执行次数，
+// 执行记录表或暂停队列表不存在时只返回任务数
+func apiListQueues(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	stats, e := backend.queueStats()
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	paused, e := backend.pausedQueues()
+	if nil != e {
+		paused = map[string]bool{}
+	}
+	now := backend.db_time_now()
+	recent, e := backend.attemptCounts(now.Add(-1*time.Hour), now)
+	if nil != e {
+		recent = map[string]map[string]int64{}
+	}
+
+	results := make([]map[string]interface{}, 0, len(stats))
+	for _, stat := range stats {
+		result := map[string]interface{}{"name": stat.queue,
+			"count":  stat.count,
+			"failed": stat.failed,
+			"paused": paused[stat.queue],
+			"recent": map[string]int64{attempt_succeeded: recent[stat.queue][attempt_succeeded],
+				attempt_retried: recent[stat.queue][attempt_retried],
+				attempt_failed:  recent[stat.queue][attempt_failed]}}
+		if !stat.oldest_run_at.IsZero() {
+			result["oldest_run_at"] = stat.oldest_run_at.Format(time.RFC3339)
+		}
+		delete(paused, stat.queue)
+		results = append(results, result)
+	}
+
+	// 已暂停但没有任务的队列也要列出， 否则无法在界面上恢复它
+	names := make([]string, 0, len(paused))
+	for name := range paused {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		results = append(results, map[string]interface{}{"name": name,
+			"count":  0,
+			"failed": 0,
+			"paused": true,
+			"recent": map[string]int64{attempt_succeeded: 0, attempt_retried: 0, attempt_failed: 0}})
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func apiPauseQueue(w http.ResponseWriter, r *http.Request, backend *dbBackend, queue string, pause bool) {
+	actor := "anonymous"
+	if p := principalFrom(r.Context()); nil != p {
+		actor = p.name
+	}
+
+	var changed bool
+	var e error
+	if pause {
+		changed, e = backend.pauseQueue(queue, actor)
+	} else {
+		changed, e = backend.resumeQueue(queue)
+	}
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+
+	if changed {
+		action := "resume_queue"
+		if pause {
+			action = "pause_queue"
+		}
+		backend.audit(r, action, 0, map[string]interface{}{"queue": queue, "paused": !pause},
+			map[string]interface{}{"queue": queue, "paused": pause})
+	}
+	writeJSON(w, http.StatusOK, map[string]interface{}{"name": queue, "paused": pause, "changed": changed})
+}
+
+func apiJobAttempts(w http.ResponseWriter, r *http.Request, backend *dbBackend, id int64) {
+	results, e := backend.attempts(id)
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+// apiPreviewSavedJob 渲染已保存的任务， 用于在任务详情中查看最终发送的内容
+func apiPreviewSavedJob(w http.ResponseWriter, r *http.Request, backend *dbBackend, id int64) {
+	job, e := backend.findJob(id)
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	if nil == job {
+		writeAPIErrorWithStatus(w, http.StatusNotFound, errJobNotFound)
+		return
+	}
+
+	result, e := previewHandler(job)
+	if nil != e {
+		writeAPIErrorWithStatus(w, previewStatus(e), e)
+		return
+	}
+	// 与 GET /api/v2/jobs/{id} 一样， 屏蔽解密后的密码等字段
+	writeJSON(w, http.StatusOK, map[string]interface{}{"type": job.handlerType(), "preview": redactMap(result)})
+}
+
+// apiStats 返回一段时间内按时间段统计的执行次数， 例如 ?range=24h&buckets=24&queue=aa
+func apiStats(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	values := r.URL.Query()
+	duration := 24 * time.Hour
+	if s := values.Get("range"); "" != s {
+		d, e := time.ParseDuration(s)
+		if nil != e || d <= 0 {
+			writeAPIErrorWithStatus(w, http.StatusBadRequest, errors.New("range must is a duration and geater zero, actual value is '"+s+"'"))
+			return
+		}
+		duration = d
+	}
+	buckets := 24
+	if s := values.Get("buckets"); "" != s {
+		i, e := strconv.Atoi(s)
+		if nil != e {
+			writeAPIErrorWithStatus(w, http.StatusBadRequest, errors.New("buckets is not a number, actual value is '"+s+"'"))
+			return
+		}
+		buckets = i
+	}
+	if buckets <= 0 || buckets > max_stats_buckets {
+		writeAPIErrorWithStatus(w, http.StatusBadRequest, errors.New("buckets must is between 1 and "+strconv.Itoa(max_stats_buckets)))
+		return
+	}
+
+	results, e := backend.throughput(backend.db_time_now(), duration, buckets, values.Get("queue"))
+	if nil != e {
+		writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+		return
+	}
+	writeJSON(w, http.StatusOK, results)
+}
+
+func apiListHandlers(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	writeJSON(w, http.StatusOK, handlerDescriptions())
+}
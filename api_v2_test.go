@@ -0,0 +1,176 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func readAPIError(t *testing.T, w *httptest.ResponseRecorder) *apiError {
+	var body struct {
+		Error *apiError `json:"error"`
+	}
+	if e := json.Unmarshal(w.Body.Bytes(), &body); nil != e {
+		t.Error(e, w.Body.String())
+		return nil
+	}
+	if nil == body.Error {
+		t.Error("error is missing -", w.Body.String())
+	}
+	return body.Error
+}
+
+func TestAPIv2Routes(t *testing.T) {
+	front := &webFront{}
+	for _, test := range []struct {
+		method string
+		url    string
+		status int
+		code   string
+	}{{method: "GET", url: "/api/v2", status: http.StatusNotFound, code: "not_found"},
+		{method: "GET", url: "/api/v2/abc", status: http.StatusNotFound, code: "not_found"},
+		{method: "GET", url: "/api/v2/jobs/abc", status: http.StatusNotFound, code: "not_found"},
+		{method: "GET", url: "/api/v2/jobs/12/abc", status: http.StatusNotFound, code: "not_found"},
+		{method: "PUT", url: "/api/v2/jobs", status: http.StatusMethodNotAllowed, code: "method_not_allowed"},
+		{method: "GET", url: "/api/v2/jobs/12/retry", status: http.StatusMethodNotAllowed, code: "method_not_allowed"},
+		{method: "DELETE", url: "/api/v2/queues", status: http.StatusMethodNotAllowed, code: "method_not_allowed"},
+		{method: "GET", url: "/api/v2/queues/aa/pause", status: http.StatusMethodNotAllowed, code: "method_not_allowed"},
+		{method: "GET", url: "/api/v2/queues/aa/abc", status: http.StatusNotFound, code: "not_found"},
+		{method: "POST", url: "/api/v2/jobs/12/attempts", status: http.StatusMethodNotAllowed, code: "method_not_allowed"},
+		{method: "POST", url: "/api/v2/stats", status: http.StatusMethodNotAllowed, code: "method_not_allowed"},
+		{method: "GET", url: "/api/v2/stats?buckets=0", status: http.StatusBadRequest, code: "bad_request"},
+		{method: "GET", url: "/api/v2/stats?range=abc", status: http.StatusBadRequest, code: "bad_request"},
+		{method: "POST", url: "/api/v2/jobs", status: http.StatusBadRequest, code: "bad_request"}} {
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest(test.method, test.url, bytes.NewBufferString("abc")))
+		if test.status != w.Code {
+			t.Error(test.method, test.url, ": excepted status is", test.status, ", actual is", w.Code)
+			continue
+		}
+		if err := readAPIError(t, w); nil != err && test.code != err.Code {
+			t.Error(test.method, test.url, ": excepted code is", test.code, ", actual is", err.Code)
+		}
+	}
+}
+
+func TestAPIv2Handlers(t *testing.T) {
+	w := httptest.NewRecorder()
+	(&webFront{}).ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/handlers", nil))
+
+	var handlers []handlerDescription
+	if e := json.Unmarshal(w.Body.Bytes(), &handlers); nil != e {
+		t.Error(e, w.Body.String())
+		return
+	}
+	found := false
+	for _, handler := range handlers {
+		if "test" == handler.Type {
+			found = true
+		}
+		if "web" == handler.Type && (nil == handler.Schema || 0 == len(handler.Aliases["content_type"])) {
+			t.Error("schema of 'web' is missing -", handler)
+		}
+	}
+	if !found {
+		t.Error("handler 'test' is missing -", handlers)
+	}
+}
+
+func TestAPIv2AuthError(t *testing.T) {
+	front := &webFront{auth: &authenticator{}}
+	w := httptest.NewRecorder()
+	front.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/jobs", nil))
+	if http.StatusUnauthorized != w.Code {
+		t.Error("excepted status is 401, actual is", w.Code)
+		return
+	}
+	if err := readAPIError(t, w); nil != err && "unauthorized" != err.Code {
+		t.Error("excepted code is unauthorized, actual is", err.Code)
+	}
+}
+
+func TestAPIv2Jobs(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		front := &webFront{dbBackend: backend}
+
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/jobs",
+			bytes.NewBufferString(`{"priority":1, "queue":"aa", "handler":{"type":"test"}}`)))
+		if http.StatusCreated != w.Code {
+			t.Error("excepted status is 201, actual is", w.Code, w.Body.String())
+			return
+		}
+
+		var created createdJob
+		if e := json.Unmarshal(w.Body.Bytes(), &created); nil != e {
+			t.Error(e)
+			return
+		}
+		if 0 == created.Id || "" == created.HandlerId {
+			t.Error("id or handler_id is missing -", w.Body.String())
+			return
+		}
+		url := "/api/v2/jobs/" + strconv.FormatInt(created.Id, 10)
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+		if http.StatusOK != w.Code {
+			t.Error("excepted status is 200, actual is", w.Code, w.Body.String())
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("POST", url+"/retry", nil))
+		if http.StatusConflict != w.Code {
+			t.Error("excepted status is 409, actual is", w.Code, w.Body.String())
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/jobs", bytes.NewBufferString(`[{"queue":"aa"}]`)))
+		if http.StatusUnprocessableEntity != w.Code {
+			t.Error("excepted status is 422, actual is", w.Code, w.Body.String())
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("DELETE", url, nil))
+		if http.StatusNoContent != w.Code {
+			t.Error("excepted status is 204, actual is", w.Code, w.Body.String())
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", url, nil))
+		if http.StatusNotFound != w.Code {
+			t.Error("excepted status is 404, actual is", w.Code, w.Body.String())
+		}
+	})
+}
+
+func TestLegacyHandlerIdFormat(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		front := &webFront{dbBackend: backend}
+
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("POST", "/api/v2/jobs",
+			bytes.NewBufferString(`{"priority":1, "queue":"aa", "handler":{"type":"test"}}`)))
+		var created createdJob
+		if e := json.Unmarshal(w.Body.Bytes(), &created); nil != e {
+			t.Error(e, w.Body.String())
+			return
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", "/all", nil))
+		if excepted := `"handler_id":{"String":"` + created.HandlerId + `","Valid":true}`; !strings.Contains(w.Body.String(), excepted) {
+			t.Error("excepted is", excepted, ", actual is", w.Body.String())
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", "/api/v2/jobs", nil))
+		if excepted := `"handler_id":"` + created.HandlerId + `"`; !strings.Contains(w.Body.String(), excepted) {
+			t.Error("excepted is", excepted, ", actual is", w.Body.String())
+		}
+	})
+}
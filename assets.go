@@ -0,0 +1,97 @@
+package delayed_job
+
+import (
+	"embed"
+	"errors"
+	"flag"
+	"io/fs"
+	"net/http"
+	"os"
+	"strings"
+)
+
+var (
+	assets_dir = flag.String("http.assets_dir", "", "the directory of the web ui files, a file in it overrides the embedded file with the same name")
+	base_path  = flag.String("http.base_path", "/", "the url prefix of the web ui and api, for example /ops/jobs/")
+)
+
+//go:embed public
+var embedded_assets embed.FS
+
+// legacy_prefixes 是以前版本使用的路径前缀， 为了兼容仍然可以访问， 较长的要放在前面
+var legacy_prefixes = []string{"/delayed_jobs/delayed_jobs", "/delayed_jobs", "/delayed_job"}
+
+// overlayFS 优先从 dir 中读取文件， 不存在时使用内嵌的文件， 这样可以只定制其中的几个文件
+type overlayFS struct {
+	dir      fs.FS
+	embedded fs.FS
+}
+
+func (self overlayFS) Open(name string) (fs.File, error) {
+	if nil != self.dir {
+		f, e := self.dir.Open(name)
+		if nil == e {
+			return f, nil
+		}
+		if !errors.Is(e, fs.ErrNotExist) {
+			return nil, e
+		}
+	}
+	return self.embedded.Open(name)
+}
+
+// assetsFS 返回 web 界面的文件， dir 为空时只使用内嵌的文件
+func assetsFS(dir string) (fs.FS, error) {
+	embedded, e := fs.Sub(embedded_assets, "public")
+	if nil != e {
+		return nil, e
+	}
+	if "" == dir {
+		return embedded, nil
+	}
+	st, e := os.Stat(dir)
+	if nil != e {
+		return nil, errors.New("http.assets_dir is invalid, " + e.Error())
+	}
+	if !st.IsDir() {
+		return nil, errors.New("http.assets_dir '" + dir + "' isn't a directory")
+	}
+	return overlayFS{dir: os.DirFS(dir), embedded: embedded}, nil
+}
+
+func assetsHandler() (http.Handler, error) {
+	assets, e := assetsFS(*assets_dir)
+	if nil != e {
+		return nil, e
+	}
+	return http.FileServer(http.FS(assets)), nil
+}
+
+// normalizeBasePath 将 base path 转换为以 '/' 开始并以 '/' 结束的格式
+func normalizeBasePath(pa string) string {
+	pa = strings.Trim(pa, "/")
+	if "" == pa {
+		return "/"
+	}
+	return "/" + pa + "/"
+}
+
+// routePath 去掉 base path 和兼容的旧前缀， 返回用于路由的路径， 不在 base path 下时返回 false
+func routePath(prefix, pa string) (string, bool) {
+	if "" != prefix && "/" != prefix {
+		if !strings.HasPrefix(pa, prefix) {
+			return "", false
+		}
+		pa = "/" + strings.TrimPrefix(pa, prefix)
+	}
+
+	for _, legacy := range legacy_prefixes {
+		if pa == legacy {
+			return "/", true
+		}
+		if strings.HasPrefix(pa, legacy+"/") {
+			return strings.TrimPrefix(pa, legacy), true
+		}
+	}
+	return pa, true
+}
@@ -0,0 +1,94 @@
+package delayed_job
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestRoutePath(t *testing.T) {
+	for _, test := range []struct {
+		prefix   string
+		pa       string
+		excepted string
+		ok       bool
+	}{{"/", "/counts", "/counts", true},
+		{"/", "/delayed_jobs/test", "/test", true},
+		{"/", "/delayed_job/handlers", "/handlers", true},
+		{"/", "/delayed_jobs/delayed_jobs/12/retry", "/12/retry", true},
+		{"/", "/delayed_jobs", "/", true},
+		{"/", "/delayed_jobsabc", "/delayed_jobsabc", true},
+		{"/ops/jobs/", "/ops/jobs/", "/", true},
+		{"/ops/jobs/", "/ops/jobs/api/v2/jobs", "/api/v2/jobs", true},
+		{"/ops/jobs/", "/ops/jobs/delayed_jobs/12", "/12", true},
+		{"/ops/jobs/", "/api/v2/jobs", "", false}} {
+		pa, ok := routePath(test.prefix, test.pa)
+		if test.ok != ok || test.excepted != pa {
+			t.Error("excepted is", test.excepted, test.ok, ", actual is", pa, ok, "for", test.prefix, test.pa)
+		}
+	}
+
+	for s, excepted := range map[string]string{"": "/", "/": "/", "ops/jobs": "/ops/jobs/", "/ops/jobs/": "/ops/jobs/"} {
+		if actual := normalizeBasePath(s); excepted != actual {
+			t.Error("excepted is", excepted, ", actual is", actual, "for", s)
+		}
+	}
+}
+
+func TestAssetsOverlay(t *testing.T) {
+	dir, e := ioutil.TempDir("", "assets")
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	defer os.RemoveAll(dir)
+	if e := ioutil.WriteFile(filepath.Join(dir, "dj_mon.css"), []byte("body {}"), 0666); nil != e {
+		t.Error(e)
+		return
+	}
+
+	assets, e := assetsFS(dir)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	front := &webFront{fs: http.FileServer(http.FS(assets))}
+	for pa, contains := range map[string]string{"/dj_mon.css": "body {}",
+		"/dj_mon.js": "loadDashboard",
+		"/":          "dj_reports_template"} {
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", pa, nil))
+		if http.StatusOK != w.Code || !strings.Contains(w.Body.String(), contains) {
+			t.Error(pa, ": status is", w.Code, ", excepted", contains, "in body")
+		}
+	}
+
+	if _, e := assetsFS(filepath.Join(dir, "dj_mon.css")); nil == e {
+		t.Error("excepted error for file")
+	}
+}
+
+func TestWebFrontBasePath(t *testing.T) {
+	front := &webFront{base_path: "/ops/jobs/"}
+	for _, test := range []struct {
+		pa     string
+		status int
+	}{{"/ops/jobs", http.StatusMovedPermanently},
+		{"/ops/jobs/", http.StatusOK},
+		{"/ops/jobs/dj_mon.js", http.StatusOK},
+		{"/ops/jobs/handlers", http.StatusOK},
+		{"/ops/jobs/delayed_jobs/handlers", http.StatusOK},
+		{"/ops/jobs/api/v2/handlers", http.StatusOK},
+		{"/handlers", http.StatusNotFound},
+		{"/dj_mon.js", http.StatusNotFound}} {
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", test.pa, nil))
+		if test.status != w.Code {
+			t.Error(test.pa, ": excepted status is", test.status, ", actual is", w.Code)
+		}
+	}
+}
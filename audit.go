@@ -0,0 +1,347 @@
+package delayed_job
+
+import (
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	audit_table = flag.String("audit.db_table", "", "the table name for the audit log, default is the job table name with suffix '_audits'")
+	audit_file  = flag.String("audit.file", "", "append the audit log to this file in the json lines format, it is disabled if empty")
+
+	audit_file_lock sync.Mutex
+)
+
+func auditTableName() string {
+	if "" != *audit_table {
+		return *audit_table
+	}
+	return *table_name + "_audits"
+}
+
+// auditRecord 是审计日志中的一条记录， 它只会被追加， 不会被修改或删除
+type auditRecord struct {
+	Id         int64       `json:"id,omitempty"`
+	Actor      string      `json:"actor"`
+	Action     string      `json:"action"`
+	JobId      int64       `json:"job_id,omitempty"`
+	Before     interface{} `json:"before,omitempty"`
+	After      interface{} `json:"after,omitempty"`
+	RemoteAddr string      `json:"remote_addr,omitempty"`
+	CreatedAt  time.Time   `json:"created_at"`
+}
+
+// auditTableScripts 返回创建审计表的语句， 表已存在时不会删除它
+func auditTableScripts(dbType int) []string {
+	name := auditTableName()
+	switch dbType {
+	case MSSQL:
+		return []string{`if object_id('dbo.` + name + `', 'U') is null
+				BEGIN
+				 CREATE TABLE dbo.` + name + ` (
+						  id                INT IDENTITY(1,1)  PRIMARY KEY,
+						  actor             varchar(200) NOT NULL,
+						  action            varchar(50) NOT NULL,
+						  job_id            int,
+						  before_value      text,
+						  after_value       text,
+						  remote_addr       varchar(200),
+						  created_at        DATETIME2 NOT NULL
+						);
+				END`}
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+				  id                SERIAL PRIMARY KEY,
+				  actor             varchar(200) NOT NULL,
+				  action            varchar(50) NOT NULL,
+				  job_id            int,
+				  before_value      text,
+				  after_value       text,
+				  remote_addr       varchar(200),
+				  created_at        timestamp with time zone NOT NULL
+				);`}
+	case ORACLE:
+		return []string{`BEGIN
+   EXECUTE IMMEDIATE 'CREATE SEQUENCE seq_` + name + `';
+EXCEPTION
+   WHEN OTHERS THEN
+      IF SQLCODE != -955 THEN
+         RAISE;
+      END IF;
+END;`,
+			`BEGIN
+   EXECUTE IMMEDIATE 'CREATE TABLE ` + name + ` (
+					  id                INTEGER DEFAULT seq_` + name + `.NEXTVAL PRIMARY KEY,
+					  actor             varchar2(200 BYTE) NOT NULL,
+					  action            varchar2(50 BYTE) NOT NULL,
+					  job_id            NUMBER(10),
+					  before_value      clob,
+					  after_value       clob,
+					  remote_addr       varchar2(200 BYTE),
+					  created_at        timestamp with time zone NOT NULL
+					)';
+EXCEPTION
+   WHEN OTHERS THEN
+      IF SQLCODE != -955 THEN
+         RAISE;
+      END IF;
+END;`}
+	case DM:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  id                INT IDENTITY(1,1)  PRIMARY KEY,
+					  actor             varchar2(200 BYTE) NOT NULL,
+					  action            varchar2(50 BYTE) NOT NULL,
+					  job_id            NUMBER(10),
+					  before_value      clob,
+					  after_value       clob,
+					  remote_addr       varchar2(200 BYTE),
+					  created_at        timestamp with time zone NOT NULL
+					)`}
+	default:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  id                SERIAL PRIMARY KEY,
+					  actor             varchar(200) NOT NULL,
+					  action            varchar(50) NOT NULL,
+					  job_id            int,
+					  before_value      text,
+					  after_value       text,
+					  remote_addr       varchar(200),
+					  created_at        DATETIME NOT NULL
+					);`}
+	}
+}
+
+func (self *dbBackend) initAuditTable() error {
+	for _, script := range auditTableScripts(self.dbType) {
+		if _, e := self.db.Exec(script); nil != e {
+			return i18n(self.dbType, self.drv, e)
+		}
+	}
+	return nil
+}
+
+func auditJSON(value interface{}) sql.NullString {
+	if nil == value {
+		return sql.NullString{}
+	}
+	bs, e := json.Marshal(value)
+	if nil != e {
+		return sql.NullString{Valid: true, String: e.Error()}
+	}
+	return sql.NullString{Valid: true, String: string(bs)}
+}
+
+func newAuditRecord(r *http.Request, action string, job_id int64, before, after interface{}) *auditRecord {
+	actor := "anonymous"
+	if p := principalFrom(r.Context()); nil != p {
+		actor = p.name
+	}
+	return &auditRecord{Actor: actor,
+		Action:     action,
+		JobId:      job_id,
+		Before:     redactValue(before),
+		After:      redactValue(after),
+		RemoteAddr: r.RemoteAddr}
+}
+
+// audit 记录一次操作， 记录失败时只打印日志， 不影响操作本身
+func (self *dbBackend) audit(r *http.Request, action string, job_id int64, before, after interface{}) {
+	record := newAuditRecord(r, action, job_id, before, after)
+	if nil != self {
+		record.CreatedAt = self.db_time_now()
+		if e := self.insertAudit(record); nil != e {
+			logger.Warn("write audit log to database failed", "action", action, "job_id", job_id, "error", e)
+		}
+	} else {
+		record.CreatedAt = time.Now()
+	}
+
+	if e := appendAuditFile(*audit_file, record); nil != e {
+		logger.Warn("write audit log to file failed", "action", action, "job_id", job_id, "error", e)
+	}
+}
+
+func (self *dbBackend) insertAudit(record *auditRecord) error {
+	var job_id sql.NullInt64
+	if 0 != record.JobId {
+		job_id = sql.NullInt64{Valid: true, Int64: record.JobId}
+	}
+
+	_, e := self.db.Exec("INSERT INTO "+auditTableName()+"(actor, action, job_id, before_value, after_value, remote_addr, created_at) VALUES("+
+		strings.Join(placeholders(self.dbType, 1, 7), ", ")+")",
+		record.Actor, record.Action, job_id, auditJSON(record.Before), auditJSON(record.After), record.RemoteAddr, record.CreatedAt)
+	if nil != e {
+		return i18n(self.dbType, self.drv, e)
+	}
+	return nil
+}
+
+func appendAuditFile(file string, record *auditRecord) error {
+	if "" == file {
+		return nil
+	}
+
+	bs, e := json.Marshal(record)
+	if nil != e {
+		return e
+	}
+	bs = append(bs, '\n')
+
+	audit_file_lock.Lock()
+	defer audit_file_lock.Unlock()
+
+	f, e := os.OpenFile(file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if nil != e {
+		return e
+	}
+	_, e = f.Write(bs)
+	if ce := f.Close(); nil == e {
+		e = ce
+	}
+	return e
+}
+
+// jobSnapshot 返回任务当前的值， 用于审计日志的 before 和 after
+func (self *dbBackend) jobSnapshot(id int64) interface{} {
+	results, e := self.where(map[string]interface{}{"@id": id})
+	if nil != e || 0 == len(results) {
+		return nil
+	}
+	return results[0]
+}
+
+// buildAuditSQL 按条件生成查询审计日志的语句， 结果按 id 倒序
+func buildAuditSQL(dbType int, params map[string]interface{}, limit int) (string, []interface{}) {
+	var buffer bytes.Buffer
+	arguments := make([]interface{}, 0, 3)
+
+	buffer.WriteString("SELECT ")
+	if MSSQL == dbType {
+		buffer.WriteString("TOP ")
+		buffer.WriteString(strconv.Itoa(limit))
+		buffer.WriteString(" ")
+	}
+	buffer.WriteString("id, actor, action, job_id, before_value, after_value, remote_addr, created_at FROM ")
+	buffer.WriteString(auditTableName())
+
+	for _, k := range []string{"actor", "action", "job_id"} {
+		v, ok := params[k]
+		if !ok || nil == v || "" == v {
+			continue
+		}
+		if 0 == len(arguments) {
+			buffer.WriteString(" WHERE ")
+		} else {
+			buffer.WriteString(" AND ")
+		}
+		buffer.WriteString(k)
+		buffer.WriteString(" = ")
+		buffer.WriteString(placeholders(dbType, len(arguments)+1, 1)[0])
+		arguments = append(arguments, v)
+	}
+
+	buffer.WriteString(" ORDER BY id DESC")
+	switch dbType {
+	case MSSQL:
+	case ORACLE:
+		buffer.WriteString(" FETCH FIRST ")
+		buffer.WriteString(strconv.Itoa(limit))
+		buffer.WriteString(" ROWS ONLY")
+	default:
+		buffer.WriteString(" LIMIT ")
+		buffer.WriteString(strconv.Itoa(limit))
+	}
+	return buffer.String(), arguments
+}
+
+func (self *dbBackend) audits(actor, action, job_id string, limit int) ([]*auditRecord, error) {
+	params := map[string]interface{}{"actor": actor, "action": action}
+	if "" != job_id {
+		id, e := strconv.ParseInt(job_id, 10, 64)
+		if nil != e {
+			return nil, errors.New("job_id is not a number, actual value is '" + job_id + "'")
+		}
+		params["job_id"] = id
+	}
+
+	query, arguments := buildAuditSQL(self.dbType, params, limit)
+	rows, e := self.db.Query(query, arguments...)
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	var results []*auditRecord
+	for rows.Next() {
+		var record auditRecord
+		var job_id sql.NullInt64
+		var before, after, remote_addr sql.NullString
+
+		e = rows.Scan(&record.Id,
+			&record.Actor,
+			&record.Action,
+			&job_id,
+			&before,
+			&after,
+			&remote_addr,
+			&record.CreatedAt)
+		if nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+
+		record.JobId = job_id.Int64
+		record.RemoteAddr = remote_addr.String
+		if before.Valid {
+			record.Before = json.RawMessage(before.String)
+		}
+		if after.Valid {
+			record.After = json.RawMessage(after.String)
+		}
+		results = append(results, &record)
+	}
+
+	if e = rows.Err(); nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	return results, nil
+}
+
+func auditHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	query := r.URL.Query()
+	limit := 100
+	if s := query.Get("limit"); "" != s {
+		i, e := strconv.Atoi(s)
+		if nil != e || i <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, "limit must is a number and geater zero, actual value is '"+s+"'")
+			return
+		}
+		limit = i
+	}
+
+	results, e := backend.audits(query.Get("actor"), query.Get("action"), query.Get("job_id"), limit)
+	if nil != e {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	if nil == results {
+		results = []*auditRecord{}
+	}
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	if e = json.NewEncoder(w).Encode(results); nil != e {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+	}
+}
@@ -0,0 +1,84 @@
+package delayed_job
+
+import (
+	"bufio"
+	"encoding/json"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestBuildAuditSQL(t *testing.T) {
+	params := map[string]interface{}{"actor": "root", "action": "", "job_id": int64(12)}
+
+	for _, test := range []struct {
+		dbType   int
+		excepted string
+	}{{dbType: POSTGRESQL, excepted: "SELECT id, actor, action, job_id, before_value, after_value, remote_addr, created_at FROM " + auditTableName() + " WHERE actor = $1 AND job_id = $2 ORDER BY id DESC LIMIT 10"},
+		{dbType: MYSQL, excepted: "SELECT id, actor, action, job_id, before_value, after_value, remote_addr, created_at FROM " + auditTableName() + " WHERE actor = ? AND job_id = ? ORDER BY id DESC LIMIT 10"},
+		{dbType: MSSQL, excepted: "SELECT TOP 10 id, actor, action, job_id, before_value, after_value, remote_addr, created_at FROM " + auditTableName() + " WHERE actor = ? AND job_id = ? ORDER BY id DESC"},
+		{dbType: ORACLE, excepted: "SELECT id, actor, action, job_id, before_value, after_value, remote_addr, created_at FROM " + auditTableName() + " WHERE actor = :1 AND job_id = :2 ORDER BY id DESC FETCH FIRST 10 ROWS ONLY"}} {
+		query, arguments := buildAuditSQL(test.dbType, params, 10)
+		if test.excepted != query {
+			t.Error("excepted is", test.excepted)
+			t.Error("actual is  ", query)
+		}
+		if 2 != len(arguments) || "root" != arguments[0] || int64(12) != arguments[1] {
+			t.Error("arguments is invalid -", arguments)
+		}
+	}
+}
+
+func TestAuditFile(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "audit.log")
+
+	r := httptest.NewRequest("POST", "/delayed_jobs/12/retry", nil)
+	r = r.WithContext(withPrincipal(r.Context(), &principal{name: "root", role: role_admin}))
+	before := map[string]interface{}{"id": 12, "handler": `{"type":"mail"}`, "password": "abc"}
+
+	for _, action := range []string{"retry", "delete"} {
+		if e := appendAuditFile(file, newAuditRecord(r, action, 12, before, nil)); nil != e {
+			t.Error(e)
+			return
+		}
+	}
+
+	f, e := os.Open(file)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	defer f.Close()
+
+	var actions []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var record map[string]interface{}
+		if e := json.Unmarshal(scanner.Bytes(), &record); nil != e {
+			t.Error(e)
+			return
+		}
+		if "root" != record["actor"] || float64(12) != record["job_id"] {
+			t.Error("record is invalid -", scanner.Text())
+		}
+		if _, ok := record["after"]; ok {
+			t.Error("after should be omitted -", scanner.Text())
+		}
+		if strings.Contains(scanner.Text(), "abc") {
+			t.Error("password isn't masked -", scanner.Text())
+		}
+		actions = append(actions, record["action"].(string))
+	}
+	if "retry,delete" != strings.Join(actions, ",") {
+		t.Error("excepted actions is retry,delete, actual is", actions)
+	}
+}
+
+func TestAuditActorIsAnonymous(t *testing.T) {
+	record := newAuditRecord(httptest.NewRequest("DELETE", "/delayed_jobs/1", nil), "delete", 1, nil, nil)
+	if "anonymous" != record.Actor {
+		t.Error("excepted actor is anonymous, actual is", record.Actor)
+	}
+}
@@ -0,0 +1,317 @@
+package delayed_job
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+// 角色， 高级别的角色拥有低级别角色的所有权限
+const (
+	role_none = iota
+	role_viewer
+	role_producer
+	role_admin
+)
+
+var (
+	auth_enabled        = flag.Bool("auth.enabled", false, "enable the authentication of the http api")
+	auth_tokens         = flag.String("auth.tokens", "", "the api tokens, split by ',', in the format of 'name:role:token', role is viewer, producer or admin")
+	auth_users          = flag.String("auth.users", "", "the users of http basic auth, split by ',', in the format of 'name:role:bcrypt_hash'")
+	auth_mtls_users     = flag.String("auth.mtls_users", "", "the common names of the client certificates, split by ',', in the format of 'common_name:role'")
+	auth_credentials    = flag.String("auth.credentials_file", "", "the file of the tokens and users, one per line in the format of 'token:name:role:token', 'user:name:role:bcrypt_hash' or 'mtls:common_name:role'")
+	auth_anonymous_role = flag.String("auth.anonymous_role", "", "the role of the requests without credentials, they are rejected if it is empty")
+	auth_realm          = flag.String("auth.realm", "delayed_job", "the realm of http basic auth")
+)
+
+var (
+	errUnauthorized = errors.New("unauthorized")
+	errForbidden    = errors.New("forbidden")
+)
+
+func parseRole(s string) (int, error) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "viewer":
+		return role_viewer, nil
+	case "producer":
+		return role_producer, nil
+	case "admin":
+		return role_admin, nil
+	default:
+		return role_none, errors.New("role '" + s + "' is unsupported")
+	}
+}
+
+func roleString(role int) string {
+	switch role {
+	case role_viewer:
+		return "viewer"
+	case role_producer:
+		return "producer"
+	case role_admin:
+		return "admin"
+	default:
+		return "none"
+	}
+}
+
+// principal 是通过认证的调用者
+type principal struct {
+	name   string
+	role   int
+	method string
+}
+
+type principalKey struct{}
+
+func withPrincipal(ctx context.Context, p *principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, p)
+}
+
+func principalFrom(ctx context.Context) *principal {
+	if p, ok := ctx.Value(principalKey{}).(*principal); ok {
+		return p
+	}
+	return nil
+}
+
+type credential struct {
+	name   string
+	role   int
+	secret string
+}
+
+type authenticator struct {
+	tokens         []credential
+	users          map[string]credential
+	mtls_users     map[string]credential
+	anonymous_role int
+}
+
+func (self *authenticator) add(kind, line string) error {
+	var ss []string
+	if "mtls" == kind {
+		ss = strings.SplitN(line, ":", 2)
+	} else {
+		ss = strings.SplitN(line, ":", 3)
+	}
+	for i := range ss {
+		ss[i] = strings.TrimSpace(ss[i])
+	}
+	if ("mtls" == kind && 2 != len(ss)) || ("mtls" != kind && 3 != len(ss)) || "" == ss[0] {
+		return errors.New(kind + " '" + ss[0] + "' is invalid")
+	}
+	role, e := parseRole(ss[1])
+	if nil != e {
+		return errors.New(kind + " '" + ss[0] + "' is invalid, " + e.Error())
+	}
+
+	switch kind {
+	case "token":
+		if "" == ss[2] {
+			return errors.New("token '" + ss[0] + "' is empty")
+		}
+		self.tokens = append(self.tokens, credential{name: ss[0], role: role, secret: ss[2]})
+	case "user":
+		if _, e := bcrypt.Cost([]byte(ss[2])); nil != e {
+			return errors.New("user '" + ss[0] + "' is invalid, the password must be a bcrypt hash")
+		}
+		self.users[ss[0]] = credential{name: ss[0], role: role, secret: ss[2]}
+	case "mtls":
+		self.mtls_users[ss[0]] = credential{name: ss[0], role: role}
+	default:
+		return errors.New("credential type '" + kind + "' is unsupported")
+	}
+	return nil
+}
+
+func (self *authenticator) addList(kind, txt string) error {
+	for _, line := range strings.Split(txt, ",") {
+		if line = strings.TrimSpace(line); "" == line {
+			continue
+		}
+		if e := self.add(kind, line); nil != e {
+			return e
+		}
+	}
+	return nil
+}
+
+// newAuthenticator 从配置中创建认证对象， 没有启用认证时返回 nil
+func newAuthenticator() (*authenticator, error) {
+	if !*auth_enabled {
+		return nil, nil
+	}
+
+	auth := &authenticator{users: map[string]credential{}, mtls_users: map[string]credential{}}
+	if e := auth.addList("token", *auth_tokens); nil != e {
+		return nil, e
+	}
+	if e := auth.addList("user", *auth_users); nil != e {
+		return nil, e
+	}
+	if e := auth.addList("mtls", *auth_mtls_users); nil != e {
+		return nil, e
+	}
+
+	if "" != *auth_credentials {
+		bs, e := os.ReadFile(*auth_credentials)
+		if nil != e {
+			return nil, errors.New("read credentials file failed, " + e.Error())
+		}
+		for _, line := range SplitLines(string(bs)) {
+			line = strings.TrimSpace(line)
+			if "" == line || strings.HasPrefix(line, "#") {
+				continue
+			}
+			kv := strings.SplitN(line, ":", 2)
+			if 2 != len(kv) {
+				return nil, errors.New("credential '" + kv[0] + "' is invalid")
+			}
+			if e := auth.add(kv[0], kv[1]); nil != e {
+				return nil, e
+			}
+		}
+	}
+
+	if "" != *auth_anonymous_role {
+		role, e := parseRole(*auth_anonymous_role)
+		if nil != e {
+			return nil, errors.New("anonymous role is invalid, " + e.Error())
+		}
+		auth.anonymous_role = role
+	}
+	return auth, nil
+}
+
+func (self *authenticator) authenticate(r *http.Request) (*principal, error) {
+	if s := r.Header.Get("Authorization"); "" != s {
+		if len(s) > 7 && strings.EqualFold(s[:7], "Bearer ") {
+			return self.authenticateToken(strings.TrimSpace(s[7:]))
+		}
+		if user, password, ok := r.BasicAuth(); ok {
+			c, found := self.users[user]
+			if !found || nil != bcrypt.CompareHashAndPassword([]byte(c.secret), []byte(password)) {
+				return nil, errUnauthorized
+			}
+			return &principal{name: c.name, role: c.role, method: "basic"}, nil
+		}
+		return nil, errUnauthorized
+	}
+
+	if s := r.Header.Get("X-API-Token"); "" != s {
+		return self.authenticateToken(s)
+	}
+
+	if nil != r.TLS && len(r.TLS.VerifiedChains) > 0 && len(r.TLS.VerifiedChains[0]) > 0 {
+		cn := r.TLS.VerifiedChains[0][0].Subject.CommonName
+		if c, found := self.mtls_users[cn]; found {
+			return &principal{name: c.name, role: c.role, method: "mtls"}, nil
+		}
+	}
+
+	if role_none != self.anonymous_role {
+		return &principal{name: "anonymous", role: self.anonymous_role, method: "anonymous"}, nil
+	}
+	return nil, errUnauthorized
+}
+
+func (self *authenticator) authenticateToken(token string) (*principal, error) {
+	for _, c := range self.tokens {
+		if 1 == subtle.ConstantTimeCompare([]byte(c.secret), []byte(token)) {
+			return &principal{name: c.name, role: c.role, method: "token"}, nil
+		}
+	}
+	return nil, errUnauthorized
+}
+
+func (self *authenticator) authorize(r *http.Request) (*principal, error) {
+	p, e := self.authenticate(r)
+	if nil != e {
+		return nil, e
+	}
+	if p.role < requiredRole(r.Method, r.URL.Path) {
+		return p, errForbidden
+	}
+	return p, nil
+}
+
+// requiredRole 返回访问某个 url 需要的角色
+func requiredRole(method, pa string) int {
+	if strings.HasPrefix(pa, "/debug/") {
+		return role_admin
+	}
+
+	switch method {
+	case "GET", "HEAD":
+		switch pa {
+		case "/settings_file", "/settings_file/reload", "/audit", "/export":
+			return role_admin
+		}
+		// 预览已保存的任务时会渲染解密后的 handler
+		if strings.HasPrefix(pa, "/api/v2/jobs/") && strings.HasSuffix(pa, "/preview") {
+			return role_admin
+		}
+		return role_viewer
+	case "PUT", "POST":
+		switch pa {
+		case "/push", "/pushAll", "/api/v2/jobs", "/preview", "/api/v2/jobs/preview":
+			return role_producer
+		}
+	}
+	return role_admin
+}
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (self *statusRecorder) WriteHeader(status int) {
+	self.status = status
+	self.ResponseWriter.WriteHeader(status)
+}
+
+func (self *statusRecorder) Write(bs []byte) (int, error) {
+	if 0 == self.status {
+		self.status = http.StatusOK
+	}
+	return self.ResponseWriter.Write(bs)
+}
+
+func (self *statusRecorder) Flush() {
+	if flusher, ok := self.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// auditRequest 记录谁在什么时候做了什么
+func auditRequest(p *principal, r *http.Request, status int) {
+	args := []interface{}{"method", r.Method,
+		"path", r.URL.Path,
+		"remote_addr", r.RemoteAddr,
+		"status", status}
+	if nil != p {
+		args = append(args, "user", p.name, "role", roleString(p.role), "auth", p.method)
+	}
+	logger.Info("audit", args...)
+}
+
+func writeAuthError(w http.ResponseWriter, e error) int {
+	if errForbidden == e {
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, e.Error())
+		return http.StatusForbidden
+	}
+	w.Header().Set("WWW-Authenticate", `Basic realm="`+*auth_realm+`", charset="UTF-8"`)
+	w.WriteHeader(http.StatusUnauthorized)
+	io.WriteString(w, e.Error())
+	return http.StatusUnauthorized
+}
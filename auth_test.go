@@ -0,0 +1,106 @@
+package delayed_job
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestAuthorize(t *testing.T) {
+	hash, e := bcrypt.GenerateFromPassword([]byte("123456"), bcrypt.MinCost)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+
+	auth := &authenticator{users: map[string]credential{}, mtls_users: map[string]credential{}}
+	if e := auth.addList("token", "app:producer:abc, monitor:viewer:def"); nil != e {
+		t.Error(e)
+		return
+	}
+	if e := auth.add("user", "root:admin:"+string(hash)); nil != e {
+		t.Error(e)
+		return
+	}
+
+	for _, test := range []struct {
+		method   string
+		url      string
+		token    string
+		user     string
+		password string
+		excepted error
+		name     string
+	}{{method: "GET", url: "/all", excepted: errUnauthorized},
+		{method: "GET", url: "/all", token: "xxx", excepted: errUnauthorized},
+		{method: "GET", url: "/all", token: "def", name: "monitor"},
+		{method: "POST", url: "/push", token: "def", excepted: errForbidden},
+		{method: "POST", url: "/push", token: "abc", name: "app"},
+		{method: "POST", url: "/api/v2/jobs", token: "abc", name: "app"},
+		{method: "DELETE", url: "/api/v2/jobs/12", token: "abc", excepted: errForbidden},
+		{method: "POST", url: "/settings_file", token: "abc", excepted: errForbidden},
+		{method: "GET", url: "/settings_file", token: "def", excepted: errForbidden},
+		{method: "GET", url: "/audit", token: "def", excepted: errForbidden},
+		{method: "GET", url: "/audit", user: "root", password: "123456", name: "root"},
+		{method: "GET", url: "/export", token: "abc", excepted: errForbidden},
+		{method: "POST", url: "/import", token: "abc", excepted: errForbidden},
+		{method: "GET", url: "/export", user: "root", password: "123456", name: "root"},
+		{method: "GET", url: "/api/v2/jobs/12/preview", token: "def", excepted: errForbidden},
+		{method: "GET", url: "/api/v2/jobs/12/preview", user: "root", password: "123456", name: "root"},
+		{method: "GET", url: "/api/v2/jobs/12", token: "def", name: "monitor"},
+		{method: "POST", url: "/12/retry", user: "root", password: "123", excepted: errUnauthorized},
+		{method: "POST", url: "/12/retry", user: "root", password: "123456", name: "root"},
+		{method: "GET", url: "/debug/pprof/", token: "abc", excepted: errForbidden}} {
+		r := httptest.NewRequest(test.method, test.url, nil)
+		if "" != test.token {
+			r.Header.Set("Authorization", "Bearer "+test.token)
+		}
+		if "" != test.user {
+			r.SetBasicAuth(test.user, test.password)
+		}
+
+		p, e := auth.authorize(r)
+		if test.excepted != e {
+			t.Error(test.method, test.url, ": excepted error is", test.excepted, ", actual is", e)
+			continue
+		}
+		if nil == e && test.name != p.name {
+			t.Error(test.method, test.url, ": excepted user is", test.name, ", actual is", p.name)
+		}
+	}
+}
+
+func TestAuthorizeAnonymous(t *testing.T) {
+	auth := &authenticator{anonymous_role: role_viewer}
+
+	if _, e := auth.authorize(httptest.NewRequest("GET", "/counts", nil)); nil != e {
+		t.Error(e)
+	}
+	if _, e := auth.authorize(httptest.NewRequest("DELETE", "/12", nil)); errForbidden != e {
+		t.Error("excepted error is forbidden, actual is", e)
+	}
+}
+
+func TestAuthInvalidCredentials(t *testing.T) {
+	auth := &authenticator{users: map[string]credential{}, mtls_users: map[string]credential{}}
+	for _, s := range []string{"app:abc", "app:root:abc"} {
+		if e := auth.add("token", s); nil == e {
+			t.Error("excepted error for", s)
+		}
+	}
+	if e := auth.add("user", "root:admin:123456"); nil == e {
+		t.Error("excepted error for the plain password")
+	}
+}
+
+func TestWriteAuthError(t *testing.T) {
+	w := httptest.NewRecorder()
+	if http.StatusUnauthorized != writeAuthError(w, errUnauthorized) {
+		t.Error("excepted status is 401")
+	}
+	if "" == w.Header().Get("WWW-Authenticate") {
+		t.Error("WWW-Authenticate is missing")
+	}
+}
@@ -0,0 +1,210 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+var bulk_batch_size = flag.Int("bulk.batch_size", 100, "the number of jobs processed in each batch of the bulk operations")
+
+const max_bulk_errors = 100
+
+// bulkRequest 是批量操作的请求， ids 和 filter 必须二选一
+type bulkRequest struct {
+	Action   string                 `json:"action"`
+	Ids      []int64                `json:"ids,omitempty"`
+	Filter   map[string]interface{} `json:"filter,omitempty"`
+	Priority *int                   `json:"priority,omitempty"`
+	Queue    *string                `json:"queue,omitempty"`
+	RunAt    string                 `json:"run_at,omitempty"`
+}
+
+type bulkError struct {
+	Id    int64  `json:"id"`
+	Error string `json:"error"`
+}
+
+// bulkResult 是批量操作的结果汇总
+type bulkResult struct {
+	Action    string      `json:"action"`
+	Matched   int         `json:"matched"`
+	Succeeded int         `json:"succeeded"`
+	Skipped   int         `json:"skipped"`
+	Failed    int         `json:"failed"`
+	Batches   int         `json:"batches"`
+	Errors    []bulkError `json:"errors,omitempty"`
+}
+
+// bulkOperation 对一个任务执行操作， 返回 false 表示任务被跳过
+type bulkOperation func(backend *dbBackend, job map[string]interface{}) (bool, error)
+
+func isRunningJob(job map[string]interface{}) bool {
+	_, locked := job["locked_by"]
+	return locked && false == job["failed"]
+}
+
+func (self *bulkRequest) operation() (bulkOperation, error) {
+	var attributes map[string]interface{}
+	switch self.Action {
+	case "retry":
+		return func(backend *dbBackend, job map[string]interface{}) (bool, error) {
+			if true != job["failed"] {
+				return false, nil
+			}
+			if e := backend.retry(job["id"].(int64)); nil != e {
+				return true, e
+			}
+			publishSnapshotEvent(event_retried, job)
+			return true, nil
+		}, nil
+	case "delete":
+		return func(backend *dbBackend, job map[string]interface{}) (bool, error) {
+			if isRunningJob(job) {
+				return false, nil
+			}
+			if e := backend.destroy(job["id"].(int64)); nil != e {
+				return true, e
+			}
+			publishSnapshotEvent(event_deleted, job)
+			return true, nil
+		}, nil
+	case "reprioritize":
+		if nil == self.Priority {
+			return nil, errors.New("priority is missing")
+		}
+		attributes = map[string]interface{}{"@priority": *self.Priority}
+	case "move":
+		if nil == self.Queue || "" == *self.Queue {
+			return nil, errors.New("queue is missing")
+		}
+		attributes = map[string]interface{}{"@queue": *self.Queue}
+	case "reschedule":
+		if "" == self.RunAt {
+			return nil, errors.New("run_at is missing")
+		}
+		run_at, e := parseQueryTime(self.RunAt)
+		if nil != e {
+			return nil, errors.New("run_at is invalid, " + e.Error())
+		}
+		attributes = map[string]interface{}{"@run_at": run_at}
+	default:
+		return nil, errors.New("action '" + self.Action + "' is unsupported, it must is retry, delete, reprioritize, move or reschedule")
+	}
+
+	return func(backend *dbBackend, job map[string]interface{}) (bool, error) {
+		if isRunningJob(job) {
+			return false, nil
+		}
+		return true, backend.update(job["id"].(int64), attributes)
+	}, nil
+}
+
+// query 将 ids 或 filter 转换为查询条件， 按 id 分批读取
+func (self *bulkRequest) query() (*jobQuery, error) {
+	if 0 == len(self.Ids) && 0 == len(self.Filter) {
+		return nil, errors.New("ids or filter is required")
+	}
+	if 0 != len(self.Ids) && 0 != len(self.Filter) {
+		return nil, errors.New("ids and filter can't be used together")
+	}
+	if len(self.Ids) > max_page_size {
+		return nil, errors.New("too many ids, the maximum is " + strconv.Itoa(max_page_size))
+	}
+
+	values := url.Values{}
+	for k, v := range self.Filter {
+		switch k {
+		case "sort", "limit", "cursor":
+			return nil, errors.New("filter '" + k + "' is unsupported")
+		}
+		values.Set(k, fmt.Sprint(v))
+	}
+	q, e := parseJobQuery(values, *bulk_batch_size)
+	if nil != e {
+		return nil, e
+	}
+	q.ids = self.Ids
+	return q, nil
+}
+
+func (self *dbBackend) bulk(req *bulkRequest) (*bulkResult, error) {
+	op, e := req.operation()
+	if nil != e {
+		return nil, e
+	}
+	q, e := req.query()
+	if nil != e {
+		return nil, e
+	}
+
+	result := &bulkResult{Action: req.Action}
+	for {
+		jobs, cursor, e := self.findJobs(q)
+		if nil != e {
+			return result, e
+		}
+		if 0 == len(jobs) {
+			break
+		}
+		result.Batches++
+
+		for _, job := range jobs {
+			result.Matched++
+			ok, e := op(self, job)
+			if nil != e {
+				result.Failed++
+				if len(result.Errors) < max_bulk_errors {
+					result.Errors = append(result.Errors, bulkError{Id: job["id"].(int64), Error: e.Error()})
+				}
+			} else if ok {
+				result.Succeeded++
+			} else {
+				result.Skipped++
+			}
+		}
+
+		if "" == cursor {
+			break
+		}
+		q.cursor = &jobCursor{Id: jobs[len(jobs)-1]["id"].(int64)}
+	}
+	return result, nil
+}
+
+func apiBulkJobs(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	var req bulkRequest
+	if e := decoder.Decode(&req); nil != e {
+		writeAPIErrorWithStatus(w, http.StatusBadRequest, e)
+		return
+	}
+
+	started_at := time.Now()
+	result, e := backend.bulk(&req)
+	if nil == result {
+		writeAPIErrorWithStatus(w, http.StatusUnprocessableEntity, e)
+		return
+	}
+	backend.audit(r, "bulk_"+req.Action, 0, &req, result)
+	loggerFrom(r.Context()).Info("bulk operation is completed", "action", req.Action,
+		"matched", result.Matched,
+		"succeeded", result.Succeeded,
+		"skipped", result.Skipped,
+		"failed", result.Failed,
+		"elapsed", time.Since(started_at))
+
+	if nil != e {
+		writeJSON(w, http.StatusInternalServerError, map[string]interface{}{
+			"error":  &apiError{Code: "internal_error", Message: e.Error()},
+			"result": result})
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
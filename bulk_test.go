@@ -0,0 +1,92 @@
+package delayed_job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestBulkRequestInvalid(t *testing.T) {
+	priority := 1
+	for _, req := range []*bulkRequest{{Action: "abc", Ids: []int64{1}},
+		{Action: "retry"},
+		{Action: "retry", Ids: []int64{1}, Filter: map[string]interface{}{"state": "failed"}},
+		{Action: "retry", Filter: map[string]interface{}{"sort": "id"}},
+		{Action: "retry", Filter: map[string]interface{}{"state": "abc"}},
+		{Action: "move", Ids: []int64{1}},
+		{Action: "reprioritize", Ids: []int64{1}},
+		{Action: "reschedule", Ids: []int64{1}, RunAt: "abc"}} {
+		_, e1 := req.operation()
+		_, e2 := req.query()
+		if nil == e1 && nil == e2 {
+			t.Error("excepted error for", req)
+		}
+	}
+
+	req := &bulkRequest{Action: "reprioritize", Priority: &priority, Filter: map[string]interface{}{"state": "failed", "type": "mail"}}
+	if _, e := req.operation(); nil != e {
+		t.Error(e)
+	}
+	q, e := req.query()
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if "failed" != q.state || "mail" != q.handler_type || *bulk_batch_size != q.limit {
+		t.Error("query is invalid -", q)
+	}
+}
+
+func TestBulkRetry(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		old := *bulk_batch_size
+		*bulk_batch_size = 2
+		defer func() {
+			*bulk_batch_size = old
+		}()
+
+		var jobs []*Job
+		for i := 0; i < 5; i++ {
+			job, e := newJob(backend, 1, 0, "", 0, "aa", time.Time{}, map[string]interface{}{"type": "test"}, false)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			jobs = append(jobs, job)
+		}
+		if e := backend.create(jobs...); nil != e {
+			t.Error(e)
+			return
+		}
+		for _, job := range jobs[:3] {
+			if e := backend.update(job.id, map[string]interface{}{"@failed_at": time.Now(), "@last_error": "connect timeout"}); nil != e {
+				t.Error(e)
+				return
+			}
+		}
+
+		result, e := backend.bulk(&bulkRequest{Action: "retry", Filter: map[string]interface{}{"state": "failed", "error": "timeout"}})
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if 3 != result.Matched || 3 != result.Succeeded || 2 != result.Batches {
+			t.Error("result is invalid -", result)
+		}
+
+		count, e := backend.count(map[string]interface{}{"@failed_at": "[notnull]"})
+		if nil != e {
+			t.Error(e)
+		} else if 0 != count {
+			t.Error("excepted failed count is 0, actual is", count)
+		}
+
+		result, e = backend.bulk(&bulkRequest{Action: "retry", Ids: []int64{jobs[3].id, jobs[4].id}})
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if 2 != result.Matched || 2 != result.Skipped {
+			t.Error("result is invalid -", result)
+		}
+	})
+}
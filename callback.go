@@ -0,0 +1,204 @@
+package delayed_job
+
+import (
+	"errors"
+	"flag"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	callback_queue        = flag.String("callback.queue", "", "the queue of the callback jobs, default is the default_queue_name")
+	callback_max_attempts = flag.Int("callback.max_attempts", 0, "the max attempts of the callback jobs, 0 is the max_attempts of worker")
+
+	callback_failure_url          = flag.String("callback.failure_url", "", "the url is requested when any job is failed permanently")
+	callback_failure_method       = flag.String("callback.failure_method", "POST", "the http method of callback.failure_url")
+	callback_failure_headers      = flag.String("callback.failure_headers", "", "the headers of callback.failure_url, one 'key=value' per line")
+	callback_failure_body         = flag.String("callback.failure_body", "", "the body template of callback.failure_url, default is the json of the job status")
+	callback_failure_content_type = flag.String("callback.failure_content_type", "application/json", "the content type of callback.failure_url")
+)
+
+const (
+	callback_succeeded = "succeeded"
+	callback_failed    = "failed"
+
+	// callback_of 是回调任务的 handler 中记录原任务 id 的字段， 回调任务本身不会再触发回调
+	callback_of = "callback_of"
+)
+
+// default_callback_body 是未指定 body 时的回调内容， job_id 保存为字符串， 避免被 json 转成浮点数
+const default_callback_body = `{"job_id": {{.job_id}}, "status": {{toJSON .status}}, "type": {{toJSON .type}}, "queue": {{toJSON .queue}}, ` +
+	`"attempts": {{toJSON .attempts}}, "last_error": {{toJSON .last_error}}, "finished_at": {{toJSON .finished_at}}}`
+
+// callbackSpec 是任务的 handler 中的 callback 字段， 例如
+//
+//	"callback": {"url": "http://example.com/notify", "method": "POST",
+//	             "headers": {"Authorization": "Bearer xxx"}, "body": "{\"id\": {{.job_id}}}",
+//	             "on": ["succeeded", "failed"]}
+type callbackSpec struct {
+	url         string
+	method      string
+	headers     map[string]interface{}
+	body        string
+	contentType string
+	on          map[string]bool
+}
+
+func readCallbackSpec(value interface{}) (*callbackSpec, error) {
+	options, ok := value.(map[string]interface{})
+	if !ok {
+		return nil, errors.New("callback must is a object")
+	}
+
+	spec := &callbackSpec{url: stringWithDefault(options, "url", ""),
+		method:      strings.ToUpper(stringWithDefault(options, "method", "POST")),
+		body:        stringWithDefault(options, "body", default_callback_body),
+		contentType: stringWithDefault(options, "content_type", "application/json")}
+	if "" == spec.url {
+		return nil, errors.New("callback.url is required")
+	}
+	switch spec.method {
+	case "GET", "PUT", "POST", "DELETE", "PATCH":
+	default:
+		return nil, errors.New("callback.method '" + spec.method + "' is unsupported")
+	}
+
+	switch headers := options["headers"].(type) {
+	case nil:
+	case map[string]interface{}:
+		spec.headers = headers
+	case string:
+		spec.headers = toKeyValues(headers, nil)
+	default:
+		return nil, errors.New("callback.headers must is a object or a string")
+	}
+
+	switch on := options["on"].(type) {
+	case nil:
+		spec.on = map[string]bool{callback_succeeded: true, callback_failed: true}
+	case string:
+		spec.on = map[string]bool{on: true}
+	case []interface{}:
+		spec.on = map[string]bool{}
+		for _, v := range on {
+			s, _ := v.(string)
+			spec.on[s] = true
+		}
+	default:
+		return nil, errors.New("callback.on must is a string or a array")
+	}
+	for status := range spec.on {
+		if callback_succeeded != status && callback_failed != status {
+			return nil, errors.New("callback.on '" + status + "' is unsupported, it must is succeeded or failed")
+		}
+	}
+	return spec, nil
+}
+
+func failureCallbackSpec() *callbackSpec {
+	if "" == *callback_failure_url {
+		return nil
+	}
+	spec := &callbackSpec{url: *callback_failure_url,
+		method:      strings.ToUpper(*callback_failure_method),
+		body:        *callback_failure_body,
+		contentType: *callback_failure_content_type,
+		on:          map[string]bool{callback_failed: true}}
+	if "" == spec.body {
+		spec.body = default_callback_body
+	}
+	if "" != *callback_failure_headers {
+		spec.headers = toKeyValues(*callback_failure_headers, nil)
+	}
+	return spec
+}
+
+// handler 生成回调任务的 handler， 回调由 webHandler 发送， url、 headers 和 body 都可以使用 payload 中的字段
+func (self *callbackSpec) handler(payload map[string]interface{}) map[string]interface{} {
+	options := map[string]interface{}{"type": "web",
+		"method":       self.method,
+		"url":          self.url,
+		"content_type": self.contentType,
+		"arguments":    payload,
+		callback_of:    payload["job_id"]}
+	if "GET" != self.method {
+		options["body"] = self.body
+	}
+	for k, v := range self.headers {
+		options[head_prefix+k] = v
+	}
+	return options
+}
+
+func callbackPayload(job *Job, status string, e error) map[string]interface{} {
+	payload := map[string]interface{}{"job_id": strconv.FormatInt(job.id, 10),
+		"status":      status,
+		"type":        job.handlerType(),
+		"queue":       job.queue,
+		"attempts":    job.attempts + 1,
+		"last_error":  "",
+		"finished_at": time.Now().Format(time.RFC3339)}
+	if nil != e {
+		// webHandler 发送前会再次用模板处理 body， 错误信息中的 '{{' 要拆开
+		payload["last_error"] = strings.Replace(redactText(e.Error()), "{{", "{ {", -1)
+	}
+	return payload
+}
+
+// callbackSpecs 返回任务在 status 时要发送的回调
+func callbackSpecs(job *Job, status string) []*callbackSpec {
+	options, e := job.attributes()
+	if nil != e {
+		options = nil
+	}
+	if _, ok := options[callback_of]; ok {
+		return nil
+	}
+
+	var specs []*callbackSpec
+	if value, ok := options["callback"]; ok && nil != value {
+		if spec, e := readCallbackSpec(value); nil != e {
+			logger.Warn("callback of job is invalid", "id", job.id, "error", e)
+		} else if spec.on[status] {
+			specs = append(specs, spec)
+		}
+	}
+	if callback_failed == status {
+		if spec := failureCallbackSpec(); nil != spec {
+			specs = append(specs, spec)
+		}
+	}
+	return specs
+}
+
+// enqueueCallbacks 将回调作为新的任务保存， 这样回调失败时会按任务的规则重试
+func (self *dbBackend) enqueueCallbacks(job *Job, status string, e error) error {
+	specs := callbackSpecs(job, status)
+	if 0 == len(specs) {
+		return nil
+	}
+
+	queue := *callback_queue
+	if "" == queue {
+		queue = *default_queue_name
+	}
+
+	payload := callbackPayload(job, status, e)
+	jobs := make([]*Job, 0, len(specs))
+	for _, spec := range specs {
+		args := map[string]interface{}{}
+		for k, v := range payload {
+			args[k] = v
+		}
+		callback, e := newJob(self, *default_priority, 0, "", *callback_max_attempts, queue, self.db_time_now(), spec.handler(args), false)
+		if nil != e {
+			return errors.New("create callback of job failed, " + e.Error())
+		}
+		jobs = append(jobs, callback)
+	}
+	if e := self.create(jobs...); nil != e {
+		return errors.New("create callback of job failed, " + e.Error())
+	}
+	return nil
+}
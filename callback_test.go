@@ -0,0 +1,145 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestReadCallbackSpec(t *testing.T) {
+	for _, value := range []interface{}{"abc",
+		map[string]interface{}{},
+		map[string]interface{}{"url": "http://127.0.0.1", "method": "abc"},
+		map[string]interface{}{"url": "http://127.0.0.1", "on": "abc"},
+		map[string]interface{}{"url": "http://127.0.0.1", "headers": 1}} {
+		if _, e := readCallbackSpec(value); nil == e {
+			t.Error("excepted error for", value)
+		}
+	}
+
+	spec, e := readCallbackSpec(map[string]interface{}{"url": "http://127.0.0.1",
+		"headers": map[string]interface{}{"a": "b"},
+		"on":      []interface{}{"failed"}})
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if "POST" != spec.method || default_callback_body != spec.body || "b" != spec.headers["a"] {
+		t.Error("spec is invalid -", spec)
+	}
+	if spec.on[callback_succeeded] || !spec.on[callback_failed] {
+		t.Error("on is invalid -", spec.on)
+	}
+}
+
+func TestCallbackHandler(t *testing.T) {
+	spec, e := readCallbackSpec(map[string]interface{}{"url": "http://127.0.0.1/{{.job_id}}",
+		"headers": map[string]interface{}{"X-Status": "{{.status}}"}})
+	if nil != e {
+		t.Error(e)
+		return
+	}
+
+	job := &Job{id: 12345678, queue: "aa", attempts: 2,
+		handler_attributes: map[string]interface{}{"type": "test"}}
+	options := spec.handler(callbackPayload(job, callback_failed, errors.New("connect timeout")))
+
+	// 模拟保存到数据库后再读取
+	bs, _ := json.Marshal(options)
+	options = nil
+	if e = json.Unmarshal(bs, &options); nil != e {
+		t.Error(e)
+		return
+	}
+
+	handler, e := newWebHandler(nil, options)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	web := handler.(*webHandler)
+	if "http://127.0.0.1/12345678" != web.urlStr {
+		t.Error("excepted url is http://127.0.0.1/12345678, actual is", web.urlStr)
+	}
+	if "failed" != web.headers["X-Status"] {
+		t.Error("excepted header is failed, actual is", web.headers["X-Status"])
+	}
+
+	var body map[string]interface{}
+	if e = json.Unmarshal([]byte(web.body.(string)), &body); nil != e {
+		t.Error(e, web.body)
+		return
+	}
+	if float64(12345678) != body["job_id"] || "failed" != body["status"] || "test" != body["type"] ||
+		"aa" != body["queue"] || float64(3) != body["attempts"] || "connect timeout" != body["last_error"] {
+		t.Error("body is invalid -", body)
+	}
+}
+
+func TestEnqueueCallbacks(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		old := *callback_failure_url
+		*callback_failure_url = "http://127.0.0.1/failed"
+		defer func() {
+			*callback_failure_url = old
+		}()
+
+		job, e := newJob(backend, 1, 0, "", 0, "aa", time.Time{}, map[string]interface{}{"type": "test",
+			"callback": map[string]interface{}{"url": "http://127.0.0.1/done", "on": "succeeded"}}, true)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if e = backend.create(job); nil != e {
+			t.Error(e)
+			return
+		}
+
+		if e = backend.enqueueCallbacks(job, callback_succeeded, nil); nil != e {
+			t.Error(e)
+			return
+		}
+		if e = backend.enqueueCallbacks(job, callback_failed, errors.New("abc")); nil != e {
+			t.Error(e)
+			return
+		}
+
+		results, e := backend.where(map[string]interface{}{})
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if 3 != len(results) {
+			t.Error("excepted count is 3, actual is", len(results))
+			return
+		}
+
+		var urls []string
+		for _, result := range results[1:] {
+			var options map[string]interface{}
+			if e = json.Unmarshal([]byte(result["handler"].(string)), &options); nil != e {
+				t.Error(e)
+				return
+			}
+			urls = append(urls, options["url"].(string))
+
+			// 回调任务本身不再触发回调
+			callback := &Job{backend: backend, handler_attributes: options}
+			if 0 != len(callbackSpecs(callback, callback_failed)) {
+				t.Error("callback of callback is enqueued")
+			}
+		}
+		if 2 != len(urls) || "http://127.0.0.1/done" != urls[0] || "http://127.0.0.1/failed" != urls[1] {
+			t.Error("urls is invalid -", urls)
+		}
+	})
+}
+
+func TestNewJobWithInvalidCallback(t *testing.T) {
+	_, e := newJob(&dbBackend{}, 1, 0, "", 0, "", time.Time{}, map[string]interface{}{"type": "test",
+		"callback": map[string]interface{}{"method": "POST"}}, true)
+	if nil == e {
+		t.Error("excepted error for the invalid callback")
+	}
+}
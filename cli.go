@@ -0,0 +1,629 @@
+package delayed_job
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/runner-mei/delayed_job/client"
+)
+
+// commands 是管理命令， 参数中的选项必须放在位置参数的前面
+var commands = []struct {
+	name  string
+	args  string
+	usage string
+}{{"push", "[-file jobs.json]", "create jobs from a json object or array, it is read from stdin if file is '-' or empty"},
+	{"list", "[-state failed] [-queue q] [-type mail] [-q text] [-limit 20] [-cursor c] [-json]", "list jobs"},
+	{"show", "<id>", "show a job"},
+	{"retry", "<id>...", "retry failed jobs"},
+	{"delete", "<id>...", "delete jobs, even if they are running"},
+	{"cancel", "<id>...", "delete jobs which aren't running"},
+	{"stats", "", "show the job counts and the queues"},
+	{"pause", "<queue>", "pause a queue, the running jobs aren't affected"},
+	{"resume", "<queue>", "resume a paused queue"},
+	{"purge", "-older-than 168h [-state failed] [-queue q]", "delete the jobs created before the duration, the running jobs are skipped"},
+	{"export", "[-file jobs.jsonl] [-state failed] [-queue q] [-type mail] [-q text] [-decrypt]", "export jobs with all fields as json lines, the encrypted fields in the handler are kept encrypted unless -decrypt is given"},
+	{"import", "[-file jobs.jsonl] [-batch 100]", "import the jobs exported by the export command, the priority, run_at, attempts and handler_id are preserved"},
+	{"test-handler", "[-file handler.json]", "run a handler once without saving it"},
+	{"config", "validate [-file delayed_job.yaml]", "check the config file and the DELAYED_JOB_* environment variables, report the unknown keys and the invalid values"}}
+
+// IsCommand 判断 name 是否是管理命令
+func IsCommand(name string) bool {
+	for _, cmd := range commands {
+		if cmd.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// PrintCommands 打印管理命令的用法
+func PrintCommands(w io.Writer) {
+	fmt.Fprintln(w, "commands:")
+	for _, cmd := range commands {
+		fmt.Fprintln(w, "  "+cmd.name+" "+cmd.args)
+		fmt.Fprintln(w, "    \t"+cmd.usage)
+	}
+	fmt.Fprintln(w, "every command accepts -server, -token, -user and -password, it connects to the db directly if -server is empty")
+}
+
+type commandContext struct {
+	dbDrv string
+	dbURL string
+
+	server   string
+	token    string
+	user     string
+	password string
+
+	stdin  io.Reader
+	stdout io.Writer
+	closer func()
+}
+
+func (self *commandContext) flagSet(name string) *flag.FlagSet {
+	fs := flag.NewFlagSet(name, flag.ContinueOnError)
+	fs.SetOutput(self.stdout)
+	fs.StringVar(&self.server, "server", "", "the url of a running http front, for example http://127.0.0.1:37078/, connect to the db directly if it is empty")
+	fs.StringVar(&self.token, "token", "", "the api token of the http front")
+	fs.StringVar(&self.user, "user", "", "the user of the http basic auth")
+	fs.StringVar(&self.password, "password", "", "the password of the http basic auth")
+	return fs
+}
+
+// localTransport 直接调用 http.Handler， 不经过网络
+type localTransport struct {
+	handler http.Handler
+}
+
+func (self localTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	w := httptest.NewRecorder()
+	self.handler.ServeHTTP(w, r)
+	return w.Result(), nil
+}
+
+// connect 返回命令使用的客户端， 没有指定 -server 时在进程内创建 web 服务并直接访问数据库，
+// 这样两种方式的行为（包括审计日志和事件）是一样的
+func (self *commandContext) connect() (*client.Client, error) {
+	if "" != self.server {
+		var options []client.Option
+		if "" != self.token {
+			options = append(options, client.WithToken(self.token))
+		} else if "" != self.user {
+			options = append(options, client.WithBasicAuth(self.user, self.password))
+		}
+		return client.New(self.server, options...), nil
+	}
+
+	if e := loadDefaultConfig(ioutil.Discard); nil != e {
+		return nil, e
+	}
+	if e := initSecrets(); nil != e {
+		return nil, e
+	}
+	initDB()
+
+	ctx := map[string]interface{}{}
+	backend, e := newBackend(self.dbDrv, self.dbURL, ctx)
+	if nil != e {
+		return nil, e
+	}
+	ctx["backend"] = backend
+	self.closer = func() {
+		backend.Close()
+	}
+
+	front := &webFront{dbBackend: backend, base_path: "/"}
+	actor := &principal{name: "cli", role: role_admin, method: "cli"}
+	if u := os.Getenv("USER"); "" != u {
+		actor.name = "cli:" + u
+	}
+	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		front.ServeHTTP(w, r.WithContext(withPrincipal(r.Context(), actor)))
+	})
+	return client.New("http://localhost/", client.WithHTTPClient(&http.Client{Transport: localTransport{handler: handler}})), nil
+}
+
+func (self *commandContext) close() {
+	if nil != self.closer {
+		self.closer()
+	}
+}
+
+// RunCommand 执行管理命令， args[0] 是命令名， 例如 []string{"retry", "12"}
+func RunCommand(dbDrv, dbURL string, args []string, stdin io.Reader, stdout io.Writer) error {
+	if 0 == len(args) {
+		PrintCommands(stdout)
+		return errors.New("command is missing")
+	}
+
+	cmd := &commandContext{dbDrv: dbDrv, dbURL: dbURL, stdin: stdin, stdout: stdout}
+	defer cmd.close()
+
+	switch args[0] {
+	case "push":
+		return cmd.push(args[1:])
+	case "list":
+		return cmd.list(args[1:])
+	case "show":
+		return cmd.show(args[1:])
+	case "retry", "delete", "cancel":
+		return cmd.each(args[0], args[1:])
+	case "stats":
+		return cmd.stats(args[1:])
+	case "pause", "resume":
+		return cmd.pause(args[0], args[1:])
+	case "purge":
+		return cmd.purge(args[1:])
+	case "export":
+		return cmd.exportJobs(args[1:])
+	case "import":
+		return cmd.importJobs(args[1:])
+	case "test-handler":
+		return cmd.testHandler(args[1:])
+	case "config":
+		return cmd.config(args[1:])
+	default:
+		PrintCommands(stdout)
+		return errors.New("command '" + args[0] + "' is unsupported")
+	}
+}
+
+func (self *commandContext) openInput(file string) (io.ReadCloser, error) {
+	if "" == file || "-" == file {
+		return ioutil.NopCloser(self.stdin), nil
+	}
+	return os.Open(file)
+}
+
+func (self *commandContext) readInput(file string) ([]byte, error) {
+	r, e := self.openInput(file)
+	if nil != e {
+		return nil, e
+	}
+	defer r.Close()
+	return ioutil.ReadAll(r)
+}
+
+func parseIds(args []string) ([]int64, error) {
+	if 0 == len(args) {
+		return nil, errors.New("job id is missing")
+	}
+	ids := make([]int64, len(args))
+	for i, s := range args {
+		id, e := strconv.ParseInt(s, 10, 64)
+		if nil != e {
+			return nil, errors.New("job id '" + s + "' is invalid")
+		}
+		ids[i] = id
+	}
+	return ids, nil
+}
+
+func jobState(job *client.JobInfo) string {
+	if job.Failed {
+		return "failed"
+	}
+	if "" != job.LockedBy {
+		return "active"
+	}
+	return "queued"
+}
+
+func jobType(job *client.JobInfo) string {
+	var handler struct {
+		Type string `json:"type"`
+	}
+	json.Unmarshal([]byte(job.Handler), &handler)
+	return handler.Type
+}
+
+func (self *commandContext) push(args []string) error {
+	fs := self.flagSet("push")
+	file := fs.String("file", "", "the json file of the jobs, read from stdin if it is '-' or empty")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	bs, e := self.readInput(*file)
+	if nil != e {
+		return e
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	results, e := c.PushJSON(context.Background(), bs)
+	if nil != e {
+		return e
+	}
+	for _, created := range results {
+		fmt.Fprintln(self.stdout, created.Id, created.HandlerId)
+	}
+	return nil
+}
+
+func (self *commandContext) list(args []string) error {
+	fs := self.flagSet("list")
+	var options client.ListOptions
+	fs.StringVar(&options.State, "state", "", "all, failed, queued or active")
+	fs.StringVar(&options.Queue, "queue", "", "the queue name")
+	fs.StringVar(&options.Type, "type", "", "the handler type")
+	fs.StringVar(&options.Text, "q", "", "search in the handler and the last error")
+	fs.IntVar(&options.Limit, "limit", 20, "the page size")
+	fs.StringVar(&options.Cursor, "cursor", "", "the cursor of the next page")
+	as_json := fs.Bool("json", false, "print the jobs as json lines")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	jobs, cursor, e := c.List(context.Background(), &options)
+	if nil != e {
+		return e
+	}
+
+	if *as_json {
+		encoder := json.NewEncoder(self.stdout)
+		for i := range jobs {
+			if e := encoder.Encode(&jobs[i]); nil != e {
+				return e
+			}
+		}
+	} else {
+		w := tabwriter.NewWriter(self.stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "ID\tQUEUE\tTYPE\tPRIORITY\tATTEMPTS\tSTATE\tRUN_AT\tLAST_ERROR")
+		for i := range jobs {
+			job := &jobs[i]
+			run_at := ""
+			if nil != job.RunAt {
+				run_at = job.RunAt.Local().Format("2006-01-02 15:04:05")
+			}
+			fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%d\t%s\t%s\t%s\n", job.Id, job.Queue, jobType(job), job.Priority, job.Attempts,
+				jobState(job), run_at, truncateText(strings.Join(strings.Fields(job.LastError), " "), 60))
+		}
+		w.Flush()
+	}
+	if "" != cursor {
+		fmt.Fprintln(self.stdout, "next cursor:", cursor)
+	}
+	return nil
+}
+
+func (self *commandContext) show(args []string) error {
+	fs := self.flagSet("show")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	ids, e := parseIds(fs.Args())
+	if nil != e {
+		return e
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	for _, id := range ids {
+		job, e := c.Get(context.Background(), id)
+		if nil != e {
+			return e
+		}
+
+		// handler 是 json 字符串， 展开后更容易阅读
+		var handler interface{}
+		if e := json.Unmarshal([]byte(job.Handler), &handler); nil != e {
+			handler = job.Handler
+		}
+		bs, e := json.Marshal(job)
+		if nil != e {
+			return e
+		}
+		var m map[string]interface{}
+		if e := json.Unmarshal(bs, &m); nil != e {
+			return e
+		}
+		m["handler"] = handler
+
+		bs, e = json.MarshalIndent(m, "", "  ")
+		if nil != e {
+			return e
+		}
+		fmt.Fprintln(self.stdout, string(bs))
+	}
+	return nil
+}
+
+// each 对每个任务执行 retry, delete 或 cancel， 出错时继续处理后面的任务
+func (self *commandContext) each(action string, args []string) error {
+	fs := self.flagSet(action)
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	ids, e := parseIds(fs.Args())
+	if nil != e {
+		return e
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	ctx := context.Background()
+	failed := 0
+	for _, id := range ids {
+		switch action {
+		case "retry":
+			_, e = c.Retry(ctx, id)
+		case "delete":
+			e = c.Delete(ctx, id)
+		default:
+			e = c.Cancel(ctx, id)
+		}
+		if nil != e {
+			failed++
+			fmt.Fprintln(self.stdout, id, action, "failed,", e)
+		} else {
+			fmt.Fprintln(self.stdout, id, action, "ok")
+		}
+	}
+	if 0 != failed {
+		return errors.New(action + " " + strconv.Itoa(failed) + " of " + strconv.Itoa(len(ids)) + " jobs failed")
+	}
+	return nil
+}
+
+func (self *commandContext) stats(args []string) error {
+	fs := self.flagSet("stats")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	ctx := context.Background()
+	counts, e := c.Counts(ctx)
+	if nil != e {
+		return e
+	}
+	queues, e := c.Queues(ctx)
+	if nil != e {
+		return e
+	}
+
+	fmt.Fprintf(self.stdout, "all: %d  queued: %d  active: %d  failed: %d\n\n", counts.All, counts.Queued, counts.Active, counts.Failed)
+	w := tabwriter.NewWriter(self.stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "QUEUE\tCOUNT\tFAILED\tPAUSED\tOLDEST_RUN_AT\tSUCCEEDED_1H\tRETRIED_1H\tFAILED_1H")
+	for _, q := range queues {
+		fmt.Fprintf(w, "%s\t%d\t%d\t%v\t%s\t%d\t%d\t%d\n", q.Name, q.Count, q.Failed, q.Paused, q.OldestRunAt,
+			q.Recent[attempt_succeeded], q.Recent[attempt_retried], q.Recent[attempt_failed])
+	}
+	return w.Flush()
+}
+
+func (self *commandContext) pause(action string, args []string) error {
+	fs := self.flagSet(action)
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	if 1 != fs.NArg() {
+		return errors.New("queue name is missing")
+	}
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+
+	var changed bool
+	if "pause" == action {
+		changed, e = c.PauseQueue(context.Background(), fs.Arg(0))
+	} else {
+		changed, e = c.ResumeQueue(context.Background(), fs.Arg(0))
+	}
+	if nil != e {
+		return e
+	}
+	if changed {
+		fmt.Fprintln(self.stdout, fs.Arg(0), action, "ok")
+	} else {
+		fmt.Fprintln(self.stdout, fs.Arg(0), "is unchanged")
+	}
+	return nil
+}
+
+func (self *commandContext) purge(args []string) error {
+	fs := self.flagSet("purge")
+	older_than := fs.Duration("older-than", 0, "delete the jobs created before the duration, for example 168h")
+	state := fs.String("state", "failed", "all, failed, queued or active")
+	queue := fs.String("queue", "", "the queue name")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	if *older_than <= 0 {
+		return errors.New("-older-than is required and must is geater zero")
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	filter := map[string]string{"state": *state,
+		"created_to": time.Now().Add(-*older_than).Format(time.RFC3339Nano)}
+	if "" != *queue {
+		filter["queue"] = *queue
+	}
+	result, e := c.Bulk(context.Background(), &client.BulkRequest{Action: "delete", Filter: filter})
+	if nil != e {
+		return e
+	}
+	fmt.Fprintf(self.stdout, "matched: %d  deleted: %d  skipped: %d  failed: %d\n", result.Matched, result.Succeeded, result.Skipped, result.Failed)
+	for _, err := range result.Errors {
+		fmt.Fprintln(self.stdout, err.Id, err.Error)
+	}
+	return nil
+}
+
+func (self *commandContext) exportJobs(args []string) error {
+	fs := self.flagSet("export")
+	file := fs.String("file", "", "the output file, write to stdout if it is '-' or empty")
+	var options client.ExportOptions
+	fs.StringVar(&options.State, "state", "", "all, failed, queued or active")
+	fs.StringVar(&options.Queue, "queue", "", "the queue name")
+	fs.StringVar(&options.Type, "type", "", "the handler type")
+	fs.StringVar(&options.Text, "q", "", "search in the handler and the last error")
+	fs.BoolVar(&options.Decrypt, "decrypt", false, "decrypt the passwords in the handler, it is required if the jobs are imported into a service with the different master key")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+
+	out := self.stdout
+	if "" != *file && "-" != *file {
+		f, e := os.Create(*file)
+		if nil != e {
+			return e
+		}
+		defer f.Close()
+		out = f
+	}
+	w := bufio.NewWriter(out)
+	count, e := c.Export(context.Background(), &options, w)
+	if nil != e {
+		w.Flush()
+		return errors.New("export failed after " + strconv.Itoa(count) + " jobs, " + e.Error())
+	}
+	if e := w.Flush(); nil != e {
+		return e
+	}
+	if out != self.stdout {
+		fmt.Fprintln(self.stdout, "exported", count, "jobs")
+	}
+	return nil
+}
+
+func (self *commandContext) importJobs(args []string) error {
+	fs := self.flagSet("import")
+	file := fs.String("file", "", "the json lines file, read from stdin if it is '-' or empty")
+	batch := fs.Int("batch", 100, "the number of jobs created in each transaction")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	if *batch <= 0 {
+		return errors.New("-batch must is geater zero")
+	}
+
+	in, e := self.openInput(*file)
+	if nil != e {
+		return e
+	}
+	defer in.Close()
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+
+	count, e := c.Import(context.Background(), in, *batch)
+	if nil != e {
+		return errors.New("import failed, " + e.Error())
+	}
+	fmt.Fprintln(self.stdout, "imported", count, "jobs")
+	return nil
+}
+
+func (self *commandContext) testHandler(args []string) error {
+	fs := self.flagSet("test-handler")
+	file := fs.String("file", "", "the json file of the handler, read from stdin if it is '-' or empty")
+	if e := fs.Parse(args); nil != e {
+		return e
+	}
+	bs, e := self.readInput(*file)
+	if nil != e {
+		return e
+	}
+
+	var handler map[string]interface{}
+	if e := json.Unmarshal(bs, &handler); nil != e {
+		return errors.New("handler is invalid, " + e.Error())
+	}
+	// 也接受 push 的格式， 即 {"handler": {...}}
+	if h, ok := handler["handler"].(map[string]interface{}); ok {
+		handler = h
+	}
+	if "" == stringWithDefault(handler, "type", "") {
+		return errors.New("handler type is required")
+	}
+
+	c, e := self.connect()
+	if nil != e {
+		return e
+	}
+	if e := c.Test(context.Background(), client.Raw(handler)); nil != e {
+		return e
+	}
+	fmt.Fprintln(self.stdout, "OK")
+	return nil
+}
+
+// config 检查配置， 它不需要连接数据库
+func (self *commandContext) config(args []string) error {
+	if 0 == len(args) || "validate" != args[0] {
+		return errors.New("usage: config validate [-file delayed_job.yaml]")
+	}
+	fs := self.flagSet("config validate")
+	file := fs.String("file", "", "the config file, the default is -delayed-config or the searched one")
+	if e := fs.Parse(args[1:]); nil != e {
+		return e
+	}
+
+	nm := *file
+	if "" == nm {
+		found := false
+		nm, found = searchFile()
+		if !found {
+			fmt.Fprintln(self.stdout, "config file '"+nm+"' isn't found, only the environment variables are checked.")
+			nm = ""
+		}
+	}
+
+	report, e := validateConfig(nm, nil)
+	if nil != e {
+		return errors.New("config '" + nm + "' is invalid, " + e.Error())
+	}
+	for _, name := range report.Unknown {
+		fmt.Fprintln(self.stdout, "unknown key:", name)
+	}
+	for _, r := range report.Invalid {
+		fmt.Fprintln(self.stdout, "invalid value:", r.Name+",", r.Error)
+	}
+	if 0 != len(report.Unknown) || 0 != len(report.Invalid) {
+		return fmt.Errorf("config '%s' has %d unknown keys and %d invalid values", nm, len(report.Unknown), len(report.Invalid))
+	}
+	if "" != nm {
+		fmt.Fprintln(self.stdout, "config '"+nm+"' is OK")
+	} else {
+		fmt.Fprintln(self.stdout, "OK")
+	}
+	return nil
+}
@@ -0,0 +1,133 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/json"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestParseIds(t *testing.T) {
+	ids, e := parseIds([]string{"1", "23"})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != len(ids) || 1 != ids[0] || 23 != ids[1] {
+		t.Error("actual is", ids)
+	}
+	if _, e := parseIds(nil); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+	if _, e := parseIds([]string{"1", "a"}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestIsCommand(t *testing.T) {
+	for _, name := range []string{"push", "list", "show", "retry", "delete", "cancel", "stats", "pause", "resume", "purge", "export", "import", "test-handler", "config"} {
+		if !IsCommand(name) {
+			t.Error(name, "isn't a command")
+		}
+	}
+	if IsCommand("init_db") {
+		t.Error("init_db is a run mode, not a command")
+	}
+	if e := RunCommand("", "", []string{"abc"}, nil, &bytes.Buffer{}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestRunCommand(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		run := func(stdin string, args ...string) string {
+			var stdout bytes.Buffer
+			if e := RunCommand(GetTestConnDrv(), GetTestConnURL(), args, strings.NewReader(stdin), &stdout); nil != e {
+				t.Fatal(args, e)
+			}
+			return stdout.String()
+		}
+
+		out := run(`[{"queue": "cli", "priority": 2, "handler": {"type": "test", "content": "a"}},
+			{"queue": "cli", "handler": {"type": "test", "content": "b"}}]`, "push")
+		lines := strings.Split(strings.TrimSpace(out), "\n")
+		if 2 != len(lines) {
+			t.Fatal("excepted 2 jobs, actual is", out)
+		}
+		id := strings.Fields(lines[0])[0]
+
+		out = run("", "list", "-queue", "cli")
+		if !strings.Contains(out, "QUEUE") || 3 != len(strings.Split(strings.TrimSpace(out), "\n")) {
+			t.Error("actual is", out)
+		}
+
+		out = run("", "show", id)
+		var job map[string]interface{}
+		if e := json.Unmarshal([]byte(out), &job); nil != e {
+			t.Fatal(e, out)
+		}
+		if "cli" != job["queue"] || "a" != job["handler"].(map[string]interface{})["content"] {
+			t.Error("actual is", job)
+		}
+
+		out = run("", "pause", "cli")
+		if !strings.Contains(out, "pause ok") {
+			t.Error("actual is", out)
+		}
+		out = run("", "stats")
+		if !strings.Contains(out, "all: 2") || !strings.Contains(out, "cli") {
+			t.Error("actual is", out)
+		}
+		run("", "resume", "cli")
+
+		exported := run("", "export", "-queue", "cli")
+		if 2 != len(strings.Split(strings.TrimSpace(exported), "\n")) {
+			t.Fatal("actual is", exported)
+		}
+
+		run("", "cancel", id)
+		if count, _ := backend.count(nil); 1 != count {
+			t.Error("excepted 1 job, actual is", count)
+		}
+
+		// 导入时 handler_id 不变， 已存在的任务会被替换
+		out = run(exported, "import")
+		if "imported 2 jobs" != strings.TrimSpace(out) {
+			t.Error("actual is", out)
+		}
+		if count, _ := backend.count(nil); 2 != count {
+			t.Error("excepted 2 jobs, actual is", count)
+		}
+
+		var stdout bytes.Buffer
+		if e := RunCommand(GetTestConnDrv(), GetTestConnURL(), []string{"retry", id}, nil, &stdout); nil == e {
+			t.Error("excepted error, actual is nil")
+		}
+
+		out = run(`{"type": "test"}`, "test-handler")
+		if "OK" != strings.TrimSpace(out) {
+			t.Error("actual is", out)
+		}
+		select {
+		case <-test_chan:
+		default:
+			t.Error("test handler isn't performed")
+		}
+
+		out = run("", "purge", "-older-than", "1h", "-state", "all")
+		if !strings.Contains(out, "matched: 0") {
+			t.Error("actual is", out)
+		}
+
+		jobs, e := backend.where(map[string]interface{}{"@queue": "cli"})
+		if nil != e {
+			t.Fatal(e)
+		}
+		for _, job := range jobs {
+			run("", "delete", strconv.FormatInt(job["id"].(int64), 10))
+		}
+		if count, _ := backend.count(nil); 0 != count {
+			t.Error("excepted 0 job, actual is", count)
+		}
+	})
+}
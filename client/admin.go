@@ -0,0 +1,170 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/url"
+	"strconv"
+)
+
+// QueueInfo 是队列的统计， Recent 是最近一小时按结果统计的执行次数
+type QueueInfo struct {
+	Name        string           `json:"name"`
+	Count       int64            `json:"count"`
+	Failed      int64            `json:"failed"`
+	Paused      bool             `json:"paused"`
+	OldestRunAt string           `json:"oldest_run_at,omitempty"`
+	Recent      map[string]int64 `json:"recent,omitempty"`
+}
+
+// Queues 返回所有队列的统计
+func (self *Client) Queues(ctx context.Context) ([]QueueInfo, error) {
+	var results []QueueInfo
+	if e := self.do(ctx, "GET", "/api/v2/queues", nil, &results, nil); nil != e {
+		return nil, e
+	}
+	return results, nil
+}
+
+// PauseQueue 暂停队列， 返回 false 表示队列本来就已暂停
+func (self *Client) PauseQueue(ctx context.Context, name string) (bool, error) {
+	return self.pauseOrResume(ctx, name, "pause")
+}
+
+// ResumeQueue 恢复队列， 返回 false 表示队列本来就没有暂停
+func (self *Client) ResumeQueue(ctx context.Context, name string) (bool, error) {
+	return self.pauseOrResume(ctx, name, "resume")
+}
+
+func (self *Client) pauseOrResume(ctx context.Context, name, action string) (bool, error) {
+	var result struct {
+		Changed bool `json:"changed"`
+	}
+	if e := self.do(ctx, "POST", "/api/v2/queues/"+url.PathEscape(name)+"/"+action, nil, &result, nil); nil != e {
+		return false, e
+	}
+	return result.Changed, nil
+}
+
+// BulkRequest 是批量操作， Action 为 retry, delete, reprioritize, move 或 reschedule，
+// Ids 和 Filter 必须二选一， Filter 的字段和 List 的查询参数相同， 例如 {"state": "failed", "created_to": "2020-01-02"}
+type BulkRequest struct {
+	Action   string            `json:"action"`
+	Ids      []int64           `json:"ids,omitempty"`
+	Filter   map[string]string `json:"filter,omitempty"`
+	Priority *int              `json:"priority,omitempty"`
+	Queue    *string           `json:"queue,omitempty"`
+	RunAt    string            `json:"run_at,omitempty"`
+}
+
+// BulkResult 是批量操作的结果， 正在运行的任务会被跳过
+type BulkResult struct {
+	Action    string `json:"action"`
+	Matched   int    `json:"matched"`
+	Succeeded int    `json:"succeeded"`
+	Skipped   int    `json:"skipped"`
+	Failed    int    `json:"failed"`
+	Batches   int    `json:"batches"`
+	Errors    []struct {
+		Id    int64  `json:"id"`
+		Error string `json:"error"`
+	} `json:"errors,omitempty"`
+}
+
+// Bulk 执行批量操作
+func (self *Client) Bulk(ctx context.Context, req *BulkRequest) (*BulkResult, error) {
+	var result BulkResult
+	if e := self.do(ctx, "POST", "/api/v2/jobs/bulk", req, &result, nil); nil != e {
+		return nil, e
+	}
+	return &result, nil
+}
+
+// Delete 删除任务， 和 Cancel 不同， 任务正在运行时也会删除它
+func (self *Client) Delete(ctx context.Context, id int64) error {
+	return self.do(ctx, "POST", "/"+strconv.FormatInt(id, 10)+"/delete", nil, nil, nil)
+}
+
+// Test 在服务端立即执行一次 handler， 不会保存任务， 用于检查 handler 的参数和配置是否正确
+func (self *Client) Test(ctx context.Context, handler HandlerBuilder) error {
+	return self.do(ctx, "POST", "/test", map[string]interface{}{"handler": handler.Handler()}, nil, nil)
+}
+
+// ExportOptions 是 Export 的查询条件， 为空的字段不参与查询
+type ExportOptions struct {
+	// State 为 all, failed, queued 或 active
+	State string
+	Queue string
+	Type  string
+	// Text 在 handler 和 last_error 中搜索
+	Text string
+	// Decrypt 为 true 时导出解密后的密码等字段， 否则保持加密， 只能导入到使用相同主密钥的服务中
+	Decrypt bool
+}
+
+// Export 将符合条件的任务以 json lines 的格式写到 w 中， 每行包含任务的所有字段， 返回导出的任务数
+func (self *Client) Export(ctx context.Context, options *ExportOptions, w io.Writer) (int, error) {
+	values := url.Values{}
+	if nil != options {
+		list := ListOptions{State: options.State, Queue: options.Queue, Type: options.Type, Text: options.Text}
+		values = list.values()
+		if options.Decrypt {
+			values.Set("decrypt", "true")
+		}
+	}
+	pa := "/export"
+	if 0 != len(values) {
+		pa += "?" + values.Encode()
+	}
+
+	resp, e := self.send(ctx, "GET", pa, "", nil)
+	if nil != e {
+		return 0, e
+	}
+	defer resp.Body.Close()
+
+	counter := &lineCounter{w: w}
+	if _, e = io.Copy(counter, resp.Body); nil != e {
+		return counter.lines, errors.New("read response failed, " + e.Error())
+	}
+	if s := resp.Trailer.Get("X-Export-Error"); "" != s {
+		return counter.lines, errors.New(s)
+	}
+	return counter.lines, nil
+}
+
+type lineCounter struct {
+	w     io.Writer
+	lines int
+}
+
+func (self *lineCounter) Write(bs []byte) (int, error) {
+	n, e := self.w.Write(bs)
+	self.lines += bytes.Count(bs[:n], []byte("\n"))
+	return n, e
+}
+
+// Import 导入 Export 导出的任务， 每 batch 个任务在一个事务中创建， batch 为 0 时使用服务端的默认值，
+// 任务的 handler_id 保持不变， 所以已存在的相同任务会被替换
+func (self *Client) Import(ctx context.Context, r io.Reader, batch int) (int, error) {
+	pa := "/import"
+	if batch > 0 {
+		pa += "?batch=" + strconv.Itoa(batch)
+	}
+	resp, e := self.send(ctx, "POST", pa, "application/x-ndjson; charset=utf-8", r)
+	if nil != e {
+		return 0, e
+	}
+	defer resp.Body.Close()
+
+	var result struct {
+		Imported int `json:"imported"`
+	}
+	if e := json.NewDecoder(resp.Body).Decode(&result); nil != e {
+		return 0, errors.New("unmarshal response failed, " + e.Error())
+	}
+	return result.Imported, nil
+}
@@ -0,0 +1,413 @@
+// Package client 是 delayed_job 的 http api 的客户端， 例如
+//
+//	c := client.New("http://127.0.0.1:37078/", client.WithToken(token))
+//	created, e := c.Push(ctx, &client.Job{Queue: "mail", Handler: &client.Mail{
+//		To:      []string{"a@example.com"},
+//		Subject: "hello",
+//		Content: "world"}})
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Client 是 delayed_job 的客户端， 可以在多个 goroutine 中同时使用
+type Client struct {
+	base_url   string
+	httpClient *http.Client
+	token      string
+	user       string
+	password   string
+}
+
+// Option 是 New 的选项
+type Option func(*Client)
+
+// WithHTTPClient 指定发送请求的 http.Client， 默认为 http.DefaultClient
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithToken 使用 api token 认证
+func WithToken(token string) Option {
+	return func(c *Client) {
+		c.token = token
+	}
+}
+
+// WithBasicAuth 使用 http basic auth 认证
+func WithBasicAuth(user, password string) Option {
+	return func(c *Client) {
+		c.user = user
+		c.password = password
+	}
+}
+
+// New 创建客户端， baseURL 是服务的地址， 包含 http.base_path， 例如 "http://127.0.0.1:37078/ops/jobs/"
+func New(baseURL string, options ...Option) *Client {
+	c := &Client{base_url: strings.TrimSuffix(baseURL, "/"), httpClient: http.DefaultClient}
+	for _, option := range options {
+		option(c)
+	}
+	return c
+}
+
+// Job 是要创建的任务
+type Job struct {
+	Handler HandlerBuilder
+
+	Priority       int
+	Queue          string
+	RunAt          time.Time
+	MaxAttempts    int
+	RepeatCount    int
+	RepeatInterval string
+}
+
+func (self *Job) toMap() (map[string]interface{}, error) {
+	if nil == self.Handler {
+		return nil, errors.New("handler is required")
+	}
+	handler := self.Handler.Handler()
+	if _, ok := handler["type"]; !ok {
+		return nil, errors.New("handler type is required")
+	}
+
+	m := map[string]interface{}{"handler": handler}
+	if 0 != self.Priority {
+		m["priority"] = self.Priority
+	}
+	if "" != self.Queue {
+		m["queue"] = self.Queue
+	}
+	if !self.RunAt.IsZero() {
+		m["run_at"] = self.RunAt.Format(time.RFC3339)
+	}
+	if 0 != self.MaxAttempts {
+		m["max_attempts"] = self.MaxAttempts
+	}
+	if 0 != self.RepeatCount {
+		m["repeat_count"] = self.RepeatCount
+	}
+	if "" != self.RepeatInterval {
+		m["repeat_interval"] = self.RepeatInterval
+	}
+	return m, nil
+}
+
+// Created 是新建任务的标识
+type Created struct {
+	Id        int64  `json:"id"`
+	HandlerId string `json:"handler_id"`
+}
+
+// JobInfo 是服务端返回的任务， Handler 是 json 格式的字符串， 其中的密码等敏感信息已被隐藏
+type JobInfo struct {
+	Id             int64      `json:"id"`
+	Priority       int        `json:"priority"`
+	Attempts       int        `json:"attempts"`
+	MaxAttempts    int        `json:"max_attempts"`
+	RepeatCount    int        `json:"repeat_count"`
+	RepeatInterval string     `json:"repeat_interval,omitempty"`
+	Queue          string     `json:"queue,omitempty"`
+	Handler        string     `json:"handler"`
+	HandlerId      string     `json:"handler_id,omitempty"`
+	LastError      string     `json:"last_error,omitempty"`
+	RunAt          *time.Time `json:"run_at,omitempty"`
+	LockedAt       *time.Time `json:"locked_at,omitempty"`
+	LockedBy       string     `json:"locked_by,omitempty"`
+	Failed         bool       `json:"failed"`
+	FailedAt       *time.Time `json:"failed_at,omitempty"`
+	CreatedAt      time.Time  `json:"created_at"`
+	UpdatedAt      time.Time  `json:"updated_at"`
+}
+
+// Counts 是各状态的任务数
+type Counts struct {
+	All    int64 `json:"all"`
+	Failed int64 `json:"failed"`
+	Active int64 `json:"active"`
+	Queued int64 `json:"queued"`
+}
+
+// ListOptions 是 List 的查询条件， 为空的字段不参与查询
+type ListOptions struct {
+	// State 为 all, failed, queued 或 active
+	State string
+	Queue string
+	Type  string
+	// Text 在 handler 和 last_error 中搜索
+	Text   string
+	Limit  int
+	Cursor string
+}
+
+func (self *ListOptions) values() url.Values {
+	values := url.Values{}
+	if nil == self {
+		return values
+	}
+	if "" != self.State {
+		values.Set("state", self.State)
+	}
+	if "" != self.Queue {
+		values.Set("queue", self.Queue)
+	}
+	if "" != self.Type {
+		values.Set("type", self.Type)
+	}
+	if "" != self.Text {
+		values.Set("q", self.Text)
+	}
+	if 0 != self.Limit {
+		values.Set("limit", strconv.Itoa(self.Limit))
+	}
+	if "" != self.Cursor {
+		values.Set("cursor", self.Cursor)
+	}
+	return values
+}
+
+// FieldError 是请求中某个字段的错误
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error 是服务端返回的错误
+type Error struct {
+	StatusCode int          `json:"-"`
+	Code       string       `json:"code"`
+	Message    string       `json:"message"`
+	Errors     []FieldError `json:"errors,omitempty"`
+}
+
+func (self *Error) Error() string {
+	if 0 == len(self.Errors) {
+		return self.Message
+	}
+	var buffer bytes.Buffer
+	buffer.WriteString(self.Message)
+	for _, fe := range self.Errors {
+		buffer.WriteString("\r\n\t")
+		buffer.WriteString(fe.Field)
+		buffer.WriteString(": ")
+		buffer.WriteString(fe.Message)
+	}
+	return buffer.String()
+}
+
+// IsNotFound 判断错误是不是任务不存在
+func IsNotFound(e error) bool {
+	var ae *Error
+	return errors.As(e, &ae) && http.StatusNotFound == ae.StatusCode
+}
+
+type idempotencyKey struct{}
+
+// WithIdempotencyKey 为 Push 和 PushAll 指定 Idempotency-Key， 相同的 key 重复提交时不会重复创建任务
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKey{}, key)
+}
+
+// Push 创建一个任务
+func (self *Client) Push(ctx context.Context, job *Job) (*Created, error) {
+	m, e := job.toMap()
+	if nil != e {
+		return nil, e
+	}
+	var result Created
+	if e := self.do(ctx, "POST", "/api/v2/jobs", m, &result, nil); nil != e {
+		return nil, e
+	}
+	return &result, nil
+}
+
+// PushAll 在一个事务中创建多个任务， 任何一个失败时都不会创建
+func (self *Client) PushAll(ctx context.Context, jobs []*Job) ([]Created, error) {
+	if 0 == len(jobs) {
+		return nil, nil
+	}
+	entities := make([]map[string]interface{}, len(jobs))
+	for i, job := range jobs {
+		m, e := job.toMap()
+		if nil != e {
+			return nil, errors.New("jobs[" + strconv.Itoa(i) + "] is invalid, " + e.Error())
+		}
+		entities[i] = m
+	}
+	var results []Created
+	if e := self.do(ctx, "POST", "/api/v2/jobs", entities, &results, nil); nil != e {
+		return nil, e
+	}
+	return results, nil
+}
+
+// PushJSON 创建任务， data 是 json 格式的一个任务或任务的数组， 格式与 http api 相同
+func (self *Client) PushJSON(ctx context.Context, data []byte) ([]Created, error) {
+	data = bytes.TrimSpace(data)
+	if 0 == len(data) {
+		return nil, errors.New("data is empty")
+	}
+	if !json.Valid(data) {
+		return nil, errors.New("data isn't a valid json")
+	}
+
+	if '[' == data[0] {
+		var results []Created
+		if e := self.do(ctx, "POST", "/api/v2/jobs", json.RawMessage(data), &results, nil); nil != e {
+			return nil, e
+		}
+		return results, nil
+	}
+
+	var result Created
+	if e := self.do(ctx, "POST", "/api/v2/jobs", json.RawMessage(data), &result, nil); nil != e {
+		return nil, e
+	}
+	return []Created{result}, nil
+}
+
+// Get 返回指定的任务， 任务不存在时返回的错误可以用 IsNotFound 判断
+func (self *Client) Get(ctx context.Context, id int64) (*JobInfo, error) {
+	var result JobInfo
+	if e := self.do(ctx, "GET", "/api/v2/jobs/"+strconv.FormatInt(id, 10), nil, &result, nil); nil != e {
+		return nil, e
+	}
+	return &result, nil
+}
+
+// Retry 重试一个失败的任务
+func (self *Client) Retry(ctx context.Context, id int64) (*JobInfo, error) {
+	var result JobInfo
+	if e := self.do(ctx, "POST", "/api/v2/jobs/"+strconv.FormatInt(id, 10)+"/retry", nil, &result, nil); nil != e {
+		return nil, e
+	}
+	return &result, nil
+}
+
+// Cancel 删除一个没有在运行的任务
+func (self *Client) Cancel(ctx context.Context, id int64) error {
+	return self.do(ctx, "DELETE", "/api/v2/jobs/"+strconv.FormatInt(id, 10), nil, nil, nil)
+}
+
+// Counts 返回各状态的任务数
+func (self *Client) Counts(ctx context.Context) (*Counts, error) {
+	var result Counts
+	if e := self.do(ctx, "GET", "/counts", nil, &result, nil); nil != e {
+		return nil, e
+	}
+	return &result, nil
+}
+
+// List 查询任务， 返回的 cursor 不为空时表示还有下一页， 将它传给 ListOptions.Cursor 读取下一页
+func (self *Client) List(ctx context.Context, options *ListOptions) ([]JobInfo, string, error) {
+	pa := "/api/v2/jobs"
+	if values := options.values(); 0 != len(values) {
+		pa += "?" + values.Encode()
+	}
+
+	var results []JobInfo
+	var header http.Header
+	if e := self.do(ctx, "GET", pa, nil, &results, &header); nil != e {
+		return nil, "", e
+	}
+	return results, header.Get("X-Next-Cursor"), nil
+}
+
+func (self *Client) do(ctx context.Context, method, pa string, body, result interface{}, header *http.Header) error {
+	var reader io.Reader
+	contentType := ""
+	if nil != body {
+		bs, e := json.Marshal(body)
+		if nil != e {
+			return errors.New("marshal request failed, " + e.Error())
+		}
+		reader = bytes.NewReader(bs)
+		contentType = "application/json; charset=utf-8"
+	}
+
+	resp, e := self.send(ctx, method, pa, contentType, reader)
+	if nil != e {
+		return e
+	}
+	defer resp.Body.Close()
+
+	bs, e := ioutil.ReadAll(resp.Body)
+	if nil != e {
+		return errors.New("read response failed, " + e.Error())
+	}
+	if nil != header {
+		*header = resp.Header
+	}
+	if nil == result || 0 == len(bytes.TrimSpace(bs)) {
+		return nil
+	}
+	if e := json.Unmarshal(bs, result); nil != e {
+		return errors.New("unmarshal response failed, " + e.Error())
+	}
+	return nil
+}
+
+// send 发送请求， 状态码不是 2xx 时返回 *Error， 否则调用者必须关闭 resp.Body
+func (self *Client) send(ctx context.Context, method, pa, contentType string, body io.Reader) (*http.Response, error) {
+	req, e := http.NewRequest(method, self.base_url+pa, body)
+	if nil != e {
+		return nil, e
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/json")
+	if "" != contentType {
+		req.Header.Set("Content-Type", contentType)
+	}
+	if key, ok := ctx.Value(idempotencyKey{}).(string); ok && "" != key {
+		req.Header.Set("Idempotency-Key", key)
+	}
+	if "" != self.token {
+		req.Header.Set("Authorization", "Bearer "+self.token)
+	} else if "" != self.user {
+		req.SetBasicAuth(self.user, self.password)
+	}
+
+	resp, e := self.httpClient.Do(req)
+	if nil != e {
+		return nil, e
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		defer resp.Body.Close()
+		bs, e := ioutil.ReadAll(resp.Body)
+		if nil != e {
+			return nil, errors.New("read response failed, " + e.Error())
+		}
+		return nil, parseError(resp.StatusCode, bs)
+	}
+	return resp, nil
+}
+
+func parseError(status int, bs []byte) error {
+	var body struct {
+		Error *Error `json:"error"`
+	}
+	if e := json.Unmarshal(bs, &body); nil == e && nil != body.Error {
+		body.Error.StatusCode = status
+		return body.Error
+	}
+
+	message := strings.TrimSpace(string(bs))
+	if "" == message {
+		message = http.StatusText(status)
+	}
+	return &Error{StatusCode: status, Message: message}
+}
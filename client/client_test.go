@@ -0,0 +1,370 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestPush(t *testing.T) {
+	var body map[string]interface{}
+	var key, authorization string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if "POST" != r.Method || "/ops/jobs/api/v2/jobs" != r.URL.Path {
+			t.Error("excepted is POST /ops/jobs/api/v2/jobs, actual is", r.Method, r.URL.Path)
+		}
+		key = r.Header.Get("Idempotency-Key")
+		authorization = r.Header.Get("Authorization")
+		bs, _ := ioutil.ReadAll(r.Body)
+		if e := json.Unmarshal(bs, &body); nil != e {
+			t.Error(e)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`{"id":12,"handler_id":"abc"}`))
+	}))
+	defer srv.Close()
+
+	run_at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	c := New(srv.URL+"/ops/jobs/", WithToken("secret"))
+	created, e := c.Push(WithIdempotencyKey(context.Background(), "k1"), &Job{Queue: "mail",
+		Priority:    2,
+		RunAt:       run_at,
+		MaxAttempts: 3,
+		Handler:     &Mail{To: []string{"a@example.com"}, Subject: "hello", Content: "world"}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 12 != created.Id || "abc" != created.HandlerId {
+		t.Error("excepted is {12 abc}, actual is", *created)
+	}
+	if "k1" != key {
+		t.Error("excepted Idempotency-Key is k1, actual is", key)
+	}
+	if "Bearer secret" != authorization {
+		t.Error("excepted Authorization is 'Bearer secret', actual is", authorization)
+	}
+
+	excepted := map[string]interface{}{"queue": "mail",
+		"priority":     float64(2),
+		"run_at":       "2020-01-02T03:04:05Z",
+		"max_attempts": float64(3),
+		"handler": map[string]interface{}{"type": "mail",
+			"to_address": []interface{}{"a@example.com"},
+			"subject":    "hello",
+			"content":    "world"}}
+	if !reflect.DeepEqual(excepted, body) {
+		t.Error("excepted is", excepted)
+		t.Error("actual is", body)
+	}
+}
+
+func TestPushWithoutHandler(t *testing.T) {
+	c := New("http://127.0.0.1:1/")
+	if _, e := c.Push(context.Background(), &Job{}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+	if _, e := c.PushAll(context.Background(), []*Job{{Handler: Raw{"content": "a"}}}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestPushAll(t *testing.T) {
+	var body []map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := ioutil.ReadAll(r.Body)
+		if e := json.Unmarshal(bs, &body); nil != e {
+			t.Error(e)
+		}
+		w.WriteHeader(http.StatusCreated)
+		w.Write([]byte(`[{"id":1,"handler_id":"a"},{"id":2,"handler_id":"b"}]`))
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	results, e := c.PushAll(context.Background(), []*Job{{Handler: &SMS{PhoneNumbers: []string{"123"}, Content: "a"}},
+		{Handler: &Redis{Commands: [][]string{{"SET", "a", "1"}}}}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if !reflect.DeepEqual([]Created{{1, "a"}, {2, "b"}}, results) {
+		t.Error("actual is", results)
+	}
+	if 2 != len(body) || "sms" != body[0]["handler"].(map[string]interface{})["type"] ||
+		"redis" != body[1]["handler"].(map[string]interface{})["type"] {
+		t.Error("actual is", body)
+	}
+}
+
+func TestError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/api/v2/jobs/1":
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error":{"code":"not_found","message":"job isn't found"}}`))
+		case "/api/v2/jobs":
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte(`{"error":{"code":"validation_failed","message":"handler is invalid","errors":[{"field":"handler.to_address","message":"is required"}]}}`))
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("db is down"))
+		}
+	}))
+	defer srv.Close()
+
+	c := New(srv.URL)
+	_, e := c.Get(context.Background(), 1)
+	if !IsNotFound(e) {
+		t.Error("excepted is not found, actual is", e)
+	}
+
+	_, e = c.Push(context.Background(), &Job{Handler: &Mail{}})
+	ae, ok := e.(*Error)
+	if !ok {
+		t.Fatal("excepted is *Error, actual is", e)
+	}
+	if http.StatusUnprocessableEntity != ae.StatusCode || "validation_failed" != ae.Code ||
+		1 != len(ae.Errors) || "handler.to_address" != ae.Errors[0].Field {
+		t.Error("actual is", *ae)
+	}
+
+	_, e = c.Counts(context.Background())
+	if nil == e || "db is down" != e.Error() || IsNotFound(e) {
+		t.Error("actual is", e)
+	}
+}
+
+func TestGetAndList(t *testing.T) {
+	var query string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json; charset=utf-8")
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/jobs/3":
+			w.Write([]byte(`{"id":3,"priority":1,"attempts":2,"max_attempts":5,"queue":"q","handler":"{\"type\":\"test\"}","handler_id":"h3",` +
+				`"last_error":"abc","failed":true,"failed_at":"2020-01-02T03:04:05Z","created_at":"2020-01-01T00:00:00Z","updated_at":"2020-01-02T03:04:05Z"}`))
+		case "POST /api/v2/jobs/3/retry":
+			w.Write([]byte(`{"id":3,"failed":false,"handler":"{}"}`))
+		case "DELETE /api/v2/jobs/3":
+			w.WriteHeader(http.StatusNoContent)
+		case "GET /counts":
+			w.Write([]byte(`{"all":4,"failed":1,"active":2,"queued":1}`))
+		case "GET /api/v2/jobs":
+			query = r.URL.RawQuery
+			w.Header().Set("X-Next-Cursor", "next")
+			w.Write([]byte(`[{"id":3,"handler":"{}"},{"id":4,"handler":"{}"}]`))
+		default:
+			t.Error("unexcepted request", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := New(srv.URL)
+	job, e := c.Get(ctx, 3)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 3 != job.Id || 2 != job.Attempts || "h3" != job.HandlerId || !job.Failed ||
+		nil == job.FailedAt || 2020 != job.FailedAt.Year() || nil != job.RunAt {
+		t.Error("actual is", *job)
+	}
+
+	job, e = c.Retry(ctx, 3)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if job.Failed {
+		t.Error("excepted job isn't failed")
+	}
+
+	if e = c.Cancel(ctx, 3); nil != e {
+		t.Error(e)
+	}
+
+	counts, e := c.Counts(ctx)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if (Counts{All: 4, Failed: 1, Active: 2, Queued: 1}) != *counts {
+		t.Error("actual is", *counts)
+	}
+
+	jobs, cursor, e := c.List(ctx, &ListOptions{State: "failed", Queue: "q", Text: "abc", Limit: 2})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != len(jobs) || 4 != jobs[1].Id || "next" != cursor {
+		t.Error("actual is", jobs, cursor)
+	}
+	if "limit=2&q=abc&queue=q&state=failed" != query {
+		t.Error("actual query is", query)
+	}
+}
+
+func TestHandlers(t *testing.T) {
+	for _, test := range []struct {
+		builder  HandlerBuilder
+		excepted map[string]interface{}
+	}{{&Web{URL: "http://a/b", Headers: map[string]string{"X-A": "1"}, Arguments: map[string]interface{}{"a": 1}},
+		map[string]interface{}{"type": "web", "method": "GET", "url": "http://a/b", "head.X-A": "1", "arguments": map[string]interface{}{"a": 1}}},
+		{&Syslog{To: []string{"127.0.0.1"}, Content: "a"},
+			map[string]interface{}{"type": "syslog", "to_address": []string{"127.0.0.1"}, "content": "a"}},
+		{&Kafka{Addresses: []string{"127.0.0.1:9092"}, Topic: "t", Content: "a"},
+			map[string]interface{}{"type": "kafka", "addresses": []string{"127.0.0.1:9092"}, "topic": "t", "content": "a"}},
+		{&Redis{Address: "127.0.0.1:6379", Commands: [][]string{{"SET", "a", "1"}}},
+			map[string]interface{}{"type": "redis", "address": "127.0.0.1:6379", "commands": []interface{}{[]string{"SET", "a", "1"}}}},
+		{&Exec{Command: "echo", CommandArguments: []string{"a"}, Environments: []string{"A=1"}},
+			map[string]interface{}{"type": "exec", "command": "echo", "command_arguments": []string{"a"}, "environments": []string{"A=1"}}},
+		{&SMS{PhoneNumbers: []string{"123"}, Content: "a"},
+			map[string]interface{}{"type": "sms", "phone_numbers": []string{"123"}, "content": "a"}},
+		{Raw{"type": "ding", "content": "a"},
+			map[string]interface{}{"type": "ding", "content": "a"}}} {
+		if actual := test.builder.Handler(); !reflect.DeepEqual(test.excepted, actual) {
+			t.Error("excepted is", test.excepted)
+			t.Error("actual is", actual)
+		}
+	}
+}
+
+func TestAdmin(t *testing.T) {
+	var bodies []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		bs, _ := ioutil.ReadAll(r.Body)
+		bodies = append(bodies, string(bs))
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v2/queues":
+			w.Write([]byte(`[{"name":"a","count":2,"failed":1,"paused":true,"recent":{"succeeded":3}}]`))
+		case "POST /api/v2/queues/a b/pause":
+			w.Write([]byte(`{"name":"a b","paused":true,"changed":true}`))
+		case "POST /api/v2/queues/a b/resume":
+			w.Write([]byte(`{"name":"a b","paused":false,"changed":false}`))
+		case "POST /api/v2/jobs/bulk":
+			w.Write([]byte(`{"action":"delete","matched":3,"succeeded":2,"skipped":1}`))
+		case "POST /3/delete":
+			w.Write([]byte("The job was deleted"))
+		case "POST /test":
+			w.WriteHeader(http.StatusInternalServerError)
+			w.Write([]byte("smtp server is unreachable"))
+		case "POST /api/v2/jobs":
+			w.WriteHeader(http.StatusCreated)
+			if strings.HasPrefix(string(bs), "[") {
+				w.Write([]byte(`[{"id":1,"handler_id":"a"}]`))
+			} else {
+				w.Write([]byte(`{"id":2,"handler_id":"b"}`))
+			}
+		default:
+			t.Error("unexcepted request", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := New(srv.URL)
+	queues, e := c.Queues(ctx)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 1 != len(queues) || "a" != queues[0].Name || !queues[0].Paused || 3 != queues[0].Recent["succeeded"] {
+		t.Error("actual is", queues)
+	}
+
+	if changed, e := c.PauseQueue(ctx, "a b"); nil != e || !changed {
+		t.Error("actual is", changed, e)
+	}
+	if changed, e := c.ResumeQueue(ctx, "a b"); nil != e || changed {
+		t.Error("actual is", changed, e)
+	}
+
+	result, e := c.Bulk(ctx, &BulkRequest{Action: "delete", Filter: map[string]string{"state": "failed"}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 3 != result.Matched || 2 != result.Succeeded || 1 != result.Skipped {
+		t.Error("actual is", *result)
+	}
+	if `{"action":"delete","filter":{"state":"failed"}}` != bodies[len(bodies)-1] {
+		t.Error("actual is", bodies[len(bodies)-1])
+	}
+
+	if e := c.Delete(ctx, 3); nil != e {
+		t.Error(e)
+	}
+	if e := c.Test(ctx, &SMS{PhoneNumbers: []string{"1"}}); nil == e || "smtp server is unreachable" != e.Error() {
+		t.Error("actual is", e)
+	}
+	if `{"handler":{"phone_numbers":["1"],"type":"sms"}}` != bodies[len(bodies)-1] {
+		t.Error("actual is", bodies[len(bodies)-1])
+	}
+
+	created, e := c.PushJSON(ctx, []byte(` [{"handler":{"type":"test"}}]`))
+	if nil != e || 1 != len(created) || 1 != created[0].Id {
+		t.Error("actual is", created, e)
+	}
+	created, e = c.PushJSON(ctx, []byte(`{"handler":{"type":"test"}}`))
+	if nil != e || 1 != len(created) || 2 != created[0].Id {
+		t.Error("actual is", created, e)
+	}
+	if _, e = c.PushJSON(ctx, []byte(`{"handler":`)); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestExportAndImport(t *testing.T) {
+	var body, contentType string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /export":
+			w.Header().Set("Trailer", "X-Export-Error")
+			w.Write([]byte("{\"id\":1}\n{\"id\":2}\n"))
+			if "true" != r.URL.Query().Get("decrypt") || "mail" != r.URL.Query().Get("queue") {
+				w.Header().Set("X-Export-Error", "query is "+r.URL.RawQuery)
+			}
+		case "POST /import":
+			bs, _ := ioutil.ReadAll(r.Body)
+			body = string(bs)
+			contentType = r.Header.Get("Content-Type")
+			if "10" != r.URL.Query().Get("batch") {
+				w.WriteHeader(http.StatusBadRequest)
+				w.Write([]byte("batch is " + r.URL.Query().Get("batch")))
+				return
+			}
+			w.Write([]byte(`{"imported":2}`))
+		default:
+			t.Error("unexcepted request", r.Method, r.URL.Path)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	ctx := context.Background()
+	c := New(srv.URL)
+	var out strings.Builder
+	count, e := c.Export(ctx, &ExportOptions{Queue: "mail", Decrypt: true}, &out)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != count || "{\"id\":1}\n{\"id\":2}\n" != out.String() {
+		t.Error("actual is", count, out.String())
+	}
+	if _, e := c.Export(ctx, nil, ioutil.Discard); nil == e || !strings.Contains(e.Error(), "query is") {
+		t.Error("excepted error from trailer, actual is", e)
+	}
+
+	count, e = c.Import(ctx, strings.NewReader(out.String()), 10)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 2 != count || out.String() != body || !strings.HasPrefix(contentType, "application/x-ndjson") {
+		t.Error("actual is", count, body, contentType)
+	}
+	if _, e := c.Import(ctx, strings.NewReader(out.String()), 0); nil == e || "batch is" != e.Error() {
+		t.Error("actual is", e)
+	}
+}
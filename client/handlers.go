@@ -0,0 +1,200 @@
+package client
+
+// HandlerBuilder 生成任务的 handler 参数， 参数中必须有 type 字段
+type HandlerBuilder interface {
+	Handler() map[string]interface{}
+}
+
+// Raw 是直接指定的 handler 参数， 用于没有 builder 的 handler， 例如
+//
+//	client.Raw{"type": "ding", "content": "hello"}
+type Raw map[string]interface{}
+
+func (self Raw) Handler() map[string]interface{} {
+	return map[string]interface{}(self)
+}
+
+func newParams(typ string, arguments map[string]interface{}) map[string]interface{} {
+	params := map[string]interface{}{"type": typ}
+	if 0 != len(arguments) {
+		params["arguments"] = arguments
+	}
+	return params
+}
+
+func setString(params map[string]interface{}, key, value string) {
+	if "" != value {
+		params[key] = value
+	}
+}
+
+func setStrings(params map[string]interface{}, key string, values []string) {
+	if 0 != len(values) {
+		params[key] = values
+	}
+}
+
+// Mail 是 mail handler 的参数， 内容中可以使用 Arguments 中的变量
+type Mail struct {
+	SMTPServer string
+	User       string
+	Password   string
+
+	From    string
+	To      []string
+	Cc      []string
+	Bcc     []string
+	Subject string
+
+	// Content 的格式由 ContentType 决定， 也可以分别指定 ContentText 和 ContentHTML
+	Content     string
+	ContentType string
+	ContentText string
+	ContentHTML string
+
+	Arguments map[string]interface{}
+}
+
+func (self *Mail) Handler() map[string]interface{} {
+	params := newParams("mail", self.Arguments)
+	setString(params, "smtp_server", self.SMTPServer)
+	setString(params, "user", self.User)
+	setString(params, "password", self.Password)
+	setString(params, "from_address", self.From)
+	setStrings(params, "to_address", self.To)
+	setStrings(params, "cc_address", self.Cc)
+	setStrings(params, "bcc_address", self.Bcc)
+	setString(params, "subject", self.Subject)
+	setString(params, "content", self.Content)
+	setString(params, "content_type", self.ContentType)
+	setString(params, "content_text", self.ContentText)
+	setString(params, "content_html", self.ContentHTML)
+	return params
+}
+
+// SMS 是 sms handler 的参数
+type SMS struct {
+	PhoneNumbers []string
+	Content      string
+
+	Arguments map[string]interface{}
+}
+
+func (self *SMS) Handler() map[string]interface{} {
+	params := newParams("sms", self.Arguments)
+	setStrings(params, "phone_numbers", self.PhoneNumbers)
+	setString(params, "content", self.Content)
+	return params
+}
+
+// Web 是 web handler 的参数， 它发送一个 http 请求
+type Web struct {
+	Method      string
+	URL         string
+	ContentType string
+	Body        string
+	Headers     map[string]string
+
+	Arguments map[string]interface{}
+}
+
+func (self *Web) Handler() map[string]interface{} {
+	params := newParams("web", self.Arguments)
+	method := self.Method
+	if "" == method {
+		method = "GET"
+	}
+	params["method"] = method
+	setString(params, "url", self.URL)
+	setString(params, "content_type", self.ContentType)
+	setString(params, "body", self.Body)
+	for k, v := range self.Headers {
+		params["head."+k] = v
+	}
+	return params
+}
+
+// Syslog 是 syslog handler 的参数， To 是 udp 地址， 没有端口时使用 514
+type Syslog struct {
+	To       []string
+	Facility string
+	Severity string
+	Hostname string
+	Tag      string
+	Content  string
+
+	Arguments map[string]interface{}
+}
+
+func (self *Syslog) Handler() map[string]interface{} {
+	params := newParams("syslog", self.Arguments)
+	setStrings(params, "to_address", self.To)
+	setString(params, "facility", self.Facility)
+	setString(params, "severity", self.Severity)
+	setString(params, "hostname", self.Hostname)
+	setString(params, "tag", self.Tag)
+	setString(params, "content", self.Content)
+	return params
+}
+
+// Kafka 是 kafka handler 的参数
+type Kafka struct {
+	Addresses []string
+	Topic     string
+	Content   string
+
+	Arguments map[string]interface{}
+}
+
+func (self *Kafka) Handler() map[string]interface{} {
+	params := newParams("kafka", self.Arguments)
+	setStrings(params, "addresses", self.Addresses)
+	setString(params, "topic", self.Topic)
+	setString(params, "content", self.Content)
+	return params
+}
+
+// Redis 是 redis handler 的参数， 每个命令是一个字符串数组， 例如 []string{"SET", "a", "1"}，
+// Address 为空时使用服务端配置的 redis
+type Redis struct {
+	Address  string
+	Password string
+	Commands [][]string
+
+	Arguments map[string]interface{}
+}
+
+func (self *Redis) Handler() map[string]interface{} {
+	params := newParams("redis", self.Arguments)
+	setString(params, "address", self.Address)
+	setString(params, "password", self.Password)
+	if 0 != len(self.Commands) {
+		commands := make([]interface{}, len(self.Commands))
+		for i, cmd := range self.Commands {
+			commands[i] = cmd
+		}
+		params["commands"] = commands
+	}
+	return params
+}
+
+// Exec 是 exec handler 的参数， CommandArguments 为空时按 shell 的规则拆分 Command
+type Exec struct {
+	Command          string
+	CommandArguments []string
+	WorkDirectory    string
+	Environments     []string
+	Prompt           string
+
+	Arguments map[string]interface{}
+}
+
+func (self *Exec) Handler() map[string]interface{} {
+	params := newParams("exec", self.Arguments)
+	setString(params, "command", self.Command)
+	setStrings(params, "command_arguments", self.CommandArguments)
+	setString(params, "work_directory", self.WorkDirectory)
+	setStrings(params, "environments", self.Environments)
+	setString(params, "prompt", self.Prompt)
+	return params
+}
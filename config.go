@@ -1,14 +1,32 @@
 package delayed_job
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"io/ioutil"
 	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"gopkg.in/yaml.v3"
 )
 
-var default_actuals = map[string]string{}
+// env_prefix 是覆盖配置的环境变量的前缀， 如 DELAYED_JOB_MAIL_SMTP_SERVER 对应 mail.smtp_server
+const env_prefix = "DELAYED_JOB_"
+
+var (
+	default_actuals = map[string]string{}
+
+	// ${NAME} 或 ${NAME:-default}
+	env_pattern = regexp.MustCompile(`\$\{([A-Za-z_][A-Za-z0-9_]*)(:-([^}]*))?\}`)
+)
 
 func loadActualFlags(flagSet *flag.FlagSet) map[string]string {
 	actual := map[string]string{}
@@ -23,81 +41,294 @@ func loadActualFlags(flagSet *flag.FlagSet) map[string]string {
 	return actual
 }
 
-func loadConfig(nm string, flagSet *flag.FlagSet, isOverride bool) error {
-	f, e := os.Open(nm)
-	if nil != e {
-		return fmt.Errorf("load config '%s' failed, %v", nm, e)
+func lookupFlag(flagSet *flag.FlagSet, nm string) *flag.Flag {
+	if nil == flagSet {
+		return flag.Lookup(nm)
 	}
+	return flagSet.Lookup(nm)
+}
 
-	var res map[string]interface{}
-	e = json.NewDecoder(f).Decode(&res)
-	if nil != e {
-		return fmt.Errorf("load config '%s' failed, %v", nm, e)
+// configFormat 根据扩展名返回配置文件的格式， 其它的扩展名都是 json
+func configFormat(nm string) string {
+	switch strings.ToLower(filepath.Ext(nm)) {
+	case ".yaml", ".yml":
+		return "yaml"
+	case ".toml":
+		return "toml"
+	default:
+		return "json"
 	}
+}
 
-	actual := map[string]string{}
-	fn := func(f *flag.Flag) {
-		actual[f.Name] = f.Name
-	}
-	if nil == flagSet {
-		flag.Visit(fn)
-	} else {
-		flagSet.Visit(fn)
+// readConfigFile 读取配置文件， 支持 json, yaml 和 toml 格式
+func readConfigFile(nm string) (map[string]interface{}, error) {
+	bs, e := ioutil.ReadFile(nm)
+	if nil != e {
+		return nil, e
 	}
 
-	e = assignFlagSet("", res, flagSet, actual, isOverride)
+	var settings map[string]interface{}
+	switch configFormat(nm) {
+	case "yaml":
+		e = yaml.Unmarshal(bs, &settings)
+	case "toml":
+		e = toml.Unmarshal(bs, &settings)
+	default:
+		decoder := json.NewDecoder(bytes.NewReader(bs))
+		decoder.UseNumber()
+		e = decoder.Decode(&settings)
+	}
 	if nil != e {
-		return fmt.Errorf("load config '%s' failed, %v", nm, e)
+		return nil, e
 	}
-	return nil
+	if nil == settings {
+		settings = map[string]interface{}{}
+	}
+	return settings, nil
 }
 
-func assignFlagSet(prefix string, res map[string]interface{}, flagSet *flag.FlagSet, actual map[string]string, isOverride bool) error {
-	for k, v := range res {
+// flattenSettings 将配置文件中嵌套的对象展开为以 '.' 连接的 flag 名
+func flattenSettings(prefix string, settings map[string]interface{}, values map[string]string) error {
+	for k, v := range settings {
 		switch value := v.(type) {
 		case map[string]interface{}:
-			e := assignFlagSet(combineName(prefix, k), value, flagSet, actual, isOverride)
-			if nil != e {
+			if e := flattenSettings(combineName(prefix, k), value, values); nil != e {
 				return e
 			}
-			continue
-		case []interface{}:
-		case string:
-		case float64:
-		case bool:
+		case []interface{}, string, float64, json.Number, bool, int, int64, uint64:
+			values[combineName(prefix, k)] = fmt.Sprint(v)
+		case time.Time:
+			// toml 和 yaml 中的日期时间
+			values[combineName(prefix, k)] = value.Format(time.RFC3339)
+		case []map[string]interface{}:
+			return errors.New("unsupported array of tables for " + combineName(prefix, k) + ", the flags can't be an array of tables")
 		case nil:
-			continue
 		default:
 			return fmt.Errorf("unsupported type for %s - %T", combineName(prefix, k), v)
 		}
-		nm := combineName(prefix, k)
+	}
+	return nil
+}
+
+// expandEnv 替换值中的 ${NAME} 和 ${NAME:-default}， 环境变量不存在又没有默认值时返回错误
+func expandEnv(value string) (string, error) {
+	var missing []string
+	value = env_pattern.ReplaceAllStringFunc(value, func(s string) string {
+		m := env_pattern.FindStringSubmatch(s)
+		if env, ok := os.LookupEnv(m[1]); ok {
+			return env
+		}
+		if "" != m[2] {
+			return m[3]
+		}
+		missing = append(missing, m[1])
+		return s
+	})
+	if 0 != len(missing) {
+		return "", errors.New("environment variable '" + strings.Join(missing, "', '") + "' isn't set")
+	}
+	return value, nil
+}
+
+func readSecretFile(nm string) (string, error) {
+	bs, e := ioutil.ReadFile(nm)
+	if nil != e {
+		return "", e
+	}
+	return strings.TrimRight(string(bs), "\r\n"), nil
+}
+
+func envName(nm string) string {
+	return env_prefix + strings.ToUpper(strings.NewReplacer(".", "_", "-", "_").Replace(nm))
+}
+
+// loadSettings 读取配置文件并展开为 flag 名到值的映射， nm 为空时只读取环境变量，
+//   - 值中的 ${NAME} 被替换为环境变量的值
+//   - 没有定义的 xxx_file 表示 xxx 的值保存在这个文件中， 如 mail.auth.password_file， 相对路径相对于配置文件所在的目录
+//   - DELAYED_JOB_* 环境变量覆盖配置文件中的值， 如 DELAYED_JOB_MAIL_SMTP_SERVER， 也支持 _FILE 后缀
+//
+// 返回的 unknown 是没有对应 flag 的环境变量
+func loadSettings(nm string, flagSet *flag.FlagSet) (settings map[string]interface{}, unknown []string, e error) {
+	var nested map[string]interface{}
+	if "" != nm {
+		nested, e = readConfigFile(nm)
+		if nil != e {
+			return nil, nil, e
+		}
+	}
+	return resolveSettings(nm, nested, flagSet)
+}
 
+// resolveSettings 与 loadSettings 一样处理已读取的配置， nm 是配置文件名， 用于 xxx_file 的相对路径
+func resolveSettings(nm string, nested map[string]interface{}, flagSet *flag.FlagSet) (settings map[string]interface{}, unknown []string, e error) {
+	values := map[string]string{}
+	if e = flattenSettings("", nested, values); nil != e {
+		return nil, nil, e
+	}
+
+	for k, v := range values {
+		if values[k], e = expandEnv(v); nil != e {
+			return nil, nil, errors.New(k + " is invalid, " + e.Error())
+		}
+	}
+	for k, v := range values {
+		if !strings.HasSuffix(k, "_file") || nil != lookupFlag(flagSet, k) {
+			continue
+		}
+		base := strings.TrimSuffix(k, "_file")
+		if nil == lookupFlag(flagSet, base) {
+			continue
+		}
+		if _, ok := values[base]; ok {
+			return nil, nil, errors.New(base + " and " + k + " can't be used together")
+		}
+		// 相对路径是相对于配置文件所在的目录
+		if !filepath.IsAbs(v) {
+			v = filepath.Join(filepath.Dir(nm), v)
+		}
+		if values[base], e = readSecretFile(v); nil != e {
+			return nil, nil, errors.New("read " + k + " failed, " + e.Error())
+		}
+		delete(values, k)
+	}
+
+	names := map[string]string{}
+	visit := func(f *flag.Flag) {
+		names[envName(f.Name)] = f.Name
+	}
+	if nil == flagSet {
+		flag.VisitAll(visit)
+	} else {
+		flagSet.VisitAll(visit)
+	}
+	for _, env := range os.Environ() {
+		kv := strings.SplitN(env, "=", 2)
+		if 2 != len(kv) || !strings.HasPrefix(kv[0], env_prefix) {
+			continue
+		}
+		if name, ok := names[kv[0]]; ok {
+			values[name] = kv[1]
+			continue
+		}
+		if name, ok := names[strings.TrimSuffix(kv[0], "_FILE")]; ok && strings.HasSuffix(kv[0], "_FILE") {
+			if values[name], e = readSecretFile(kv[1]); nil != e {
+				return nil, nil, errors.New("read " + kv[0] + " failed, " + e.Error())
+			}
+			continue
+		}
+		unknown = append(unknown, kv[0])
+	}
+
+	settings = make(map[string]interface{}, len(values))
+	for k, v := range values {
+		settings[k] = v
+	}
+	sort.Strings(unknown)
+	return settings, unknown, nil
+}
+
+// loadConfig 加载配置文件和 DELAYED_JOB_* 环境变量， nm 为空时只加载环境变量
+func loadConfig(nm string, flagSet *flag.FlagSet, isOverride bool) error {
+	settings, unknown_envs, e := loadSettings(nm, flagSet)
+	if nil != e {
+		return fmt.Errorf("load config '%s' failed, %v", nm, e)
+	}
+
+	if nil == flagSet {
+		// 重新加载全部的配置， 之前热加载的值不再使用
+		config_mu.Lock()
+		defer config_mu.Unlock()
+		config_values = map[string]flag.Value{}
+	}
+
+	unknown, e := assignFlagSet("", settings, flagSet, loadActualFlags(flagSet), isOverride)
+	if nil != e {
+		return fmt.Errorf("load config '%s' failed, %v", nm, e)
+	}
+	unknown = append(unknown, unknown_envs...)
+	if 0 != len(unknown) {
+		logger.Warn("unknown keys in config are ignored, run 'config validate' for details", "file", nm, "keys", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// assignFlagSet 将配置设置到 flag 中， 返回没有对应 flag 的配置名
+func assignFlagSet(prefix string, res map[string]interface{}, flagSet *flag.FlagSet, actual map[string]string, isOverride bool) ([]string, error) {
+	values := map[string]string{}
+	if e := flattenSettings(prefix, res, values); nil != e {
+		return nil, e
+	}
+	names := make([]string, 0, len(values))
+	for nm := range values {
+		names = append(names, nm)
+	}
+	sort.Strings(names)
+
+	var unknown []string
+	for _, nm := range names {
 		if !isOverride {
 			if _, ok := actual[nm]; ok {
-				log.Printf("load flag '%s' from config is skipped.\n", nm)
+				logger.Info("flag is set by the command line, the value in config is skipped", "name", nm)
 				continue
 			}
 		}
 
-		var g *flag.Flag
-		if nil == flagSet {
-			g = flag.Lookup(nm)
+		g := lookupFlag(flagSet, nm)
+		if nil == g {
+			unknown = append(unknown, nm)
+			continue
+		}
+
+		err := g.Value.Set(values[nm])
+		if nil != err {
+			return nil, errors.New(nm + " is invalid, " + err.Error())
+		}
+		// db_url 等连接字符串中可能有密码
+		if isSecretField(nm) {
+			logger.Info("set flag from config", "name", nm, "value", redacted_text)
 		} else {
-			g = flagSet.Lookup(nm)
+			logger.Info("set flag from config", "name", nm, "value", redactText(values[nm]))
 		}
+	}
+	return unknown, nil
+}
+
+// configReport 是 config validate 的结果
+type configReport struct {
+	Unknown []string
+	Invalid []rejectedSetting
+}
 
+// validateConfig 检查配置文件和环境变量， nm 为空时只检查环境变量
+func validateConfig(nm string, flagSet *flag.FlagSet) (*configReport, error) {
+	settings, unknown_envs, e := loadSettings(nm, flagSet)
+	if nil != e {
+		return nil, e
+	}
+	names := make([]string, 0, len(settings))
+	for k := range settings {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+
+	report := &configReport{}
+	for _, k := range names {
+		g := lookupFlag(flagSet, k)
 		if nil == g {
-			log.Printf("flag '%s' is not defined.\n", nm)
+			report.Unknown = append(report.Unknown, k)
 			continue
 		}
-
-		err := g.Value.Set(fmt.Sprint(v))
-		if nil != err {
-			return err
+		// 在一个新的值中检查， 不修改 flag 本身
+		value, ok := newFlagValue(g)
+		if !ok {
+			continue
+		}
+		if e := value.Set(fmt.Sprint(settings[k])); nil != e {
+			report.Invalid = append(report.Invalid, rejectedSetting{Name: k, Error: e.Error()})
 		}
-		log.Println("set", nm, "=", v)
 	}
-	return nil
+	report.Unknown = append(report.Unknown, unknown_envs...)
+	return report, nil
 }
 
 func combineName(prefix, nm string) string {
@@ -456,6 +456,16 @@ func (self *dbBackend) reserve(w *worker) (*Job, error) {
 			buffer.WriteString(")")
 		}
 	}
+	// 暂停的队列名来自 http 请求， 必须作为参数传入， 参数的序号在 reserve 的参数之后
+	paused_start := 1
+	switch self.dbType {
+	case ORACLE, DM:
+		paused_start = 4
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		paused_start = 6
+	}
+	paused_condition, paused_args := pausedQueuesCondition(self.dbType, paused_start, w.pausedQueues(self))
+	buffer.WriteString(paused_condition)
 	buffer.WriteString(" ORDER BY priority ASC, run_at ASC")
 
 	now := self.db_time_now()
@@ -466,7 +476,7 @@ func (self *dbBackend) reserve(w *worker) (*Job, error) {
 		sqlStr := "UPDATE " + *table_name + " SET locked_at = $1, locked_by = $2 WHERE id in (SELECT id FROM " + *table_name +
 			buffer.String() + " LIMIT 1) RETURNING " + fields_sql_string
 		// fmt.Println(sqlStr, now, w.name, now, now.Truncate(w.max_run_time), w.name)
-		rows, e := self.db.Query(sqlStr, now, w.name, now, now.Truncate(w.max_run_time), w.name)
+		rows, e := self.db.Query(sqlStr, append([]interface{}{now, w.name, now, now.Truncate(w.max_run_time), w.name}, paused_args...)...)
 		if nil != e {
 			if sql.ErrNoRows == e {
 				return nil, nil
@@ -482,7 +492,7 @@ func (self *dbBackend) reserve(w *worker) (*Job, error) {
 	default:
 		// fmt.Println("=====", select_sql_string+buffer.String())
 		// fmt.Println(buffer.String(), ",", now, now.Truncate(w.max_run_time), w.name)
-		rows, e := self.db.Query(select_sql_string+buffer.String(), now, now.Truncate(w.max_run_time), w.name)
+		rows, e := self.db.Query(select_sql_string+buffer.String(), append([]interface{}{now, now.Truncate(w.max_run_time), w.name}, paused_args...)...)
 		if nil != e {
 			if sql.ErrNoRows == e {
 				return nil, nil
@@ -608,71 +618,110 @@ func (self *dbBackend) create(jobs ...*Job) (e error) {
 	}()
 
 	for _, job := range jobs {
-		if job.run_at.IsZero() {
-			job.run_at = now.Truncate(10 * time.Second)
+		if e = self.insertJob(tx, job, now); nil != e {
+			return e
 		}
+	}
 
-		// var queue sql.NullString
-		// if 0 == len(job.queue) {
-		// 	queue.Valid = false
-		// } else {
-		// 	queue.Valid = true
-		// 	queue.String = job.queue
-		// }
-
-		//1         2         3      4        5           NULL        6       NULL       NULL       NULL       7           8
-		//priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at
-		switch self.dbType {
-		case ORACLE, DM:
-			_, e = tx.Exec("DELETE FROM "+*table_name+" WHERE handler_id = :1", job.handler_id)
-			if nil != e {
-				break
-			}
+	isCommited = true
+	e = tx.Commit()
+	if nil != e {
+		return errors.New("commit transaction failed, " + i18nString(self.dbType, self.drv, e))
+	}
 
-			// _, e = tx.Exec("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (:1, :2, :3, :4, :5, NULL, :6, NULL, NULL, NULL, :7, :8)",
-			// 	job.priority, job.attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
-			// fmt.Println("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (:1, :2, :3, :4, :5, NULL, :6, NULL, NULL, NULL, :7, :8)",
-			// 	job.priority, job.attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
-			now_str := now.Format("2006-01-02 15:04:05")
-			_, e = tx.Exec(fmt.Sprintf("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, run_at, created_at, updated_at) VALUES (%d, %d, '%d', %d, %d,'%s', :1, '%s', TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'))",
-				job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, job.handler_id, job.run_at.Format("2006-01-02 15:04:05"), now_str, now_str), job.handler)
-			//fmt.Println(fmt.Sprintf("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, run_at, created_at, updated_at) VALUES (%d, %d, '%s', :1, '%s', TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'))",
-			//	job.priority, job.attempts, job.queue, job.handler_id, job.run_at.Format("2006-01-02 15:04:05"), now_str, now_str), job.handler)
-		case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
-			_, e = tx.Exec("DELETE FROM "+*table_name+" WHERE handler_id = $1", job.handler_id)
-			if nil != e {
-				break
-			}
+	for _, job := range jobs {
+		metrics_jobs_enqueued.Inc(job.handlerType(), job.queue)
+		publishJobEvent(event_created, job, nil)
+	}
+	return nil
+}
 
-			_, e = tx.Exec("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULL, $9, NULL, NULL, NULL, $10, $11)",
-				job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
-			// fmt.Println("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULL, $9, NULL, NULL, NULL, $10, $11)",
-			//	job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
-		default:
-			_, e = tx.Exec("DELETE FROM "+*table_name+" WHERE handler_id = ?", job.handler_id)
-			if nil != e {
-				break
-			}
+// insertJob 在事务中插入任务， 已存在相同 handler_id 的任务时先删除它， 插入后 job.id 为新任务的 id
+func (self *dbBackend) insertJob(tx *sql.Tx, job *Job, now time.Time) error {
+	if job.run_at.IsZero() {
+		job.run_at = now.Truncate(10 * time.Second)
+	}
+
+	// 数据库中保存的是加密后的 handler
+	handler, e := encryptHandler(job.handler)
+	if nil != e {
+		return e
+	}
+
+	// var queue sql.NullString
+	// if 0 == len(job.queue) {
+	// 	queue.Valid = false
+	// } else {
+	// 	queue.Valid = true
+	// 	queue.String = job.queue
+	// }
+
+	//1         2         3      4        5           NULL        6       NULL       NULL       NULL       7           8
+	//priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at
+	switch self.dbType {
+	case ORACLE, DM:
+		_, e = tx.Exec("DELETE FROM "+*table_name+" WHERE handler_id = :1", job.handler_id)
+		if nil != e {
+			break
+		}
 
-			_, e = tx.Exec("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL, ?, NULL, NULL, NULL, ?, ?)",
-				job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
-			//fmt.Println("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, NULL, ?, NULL, NULL, NULL, ?, ?)",
-			//	job.priority, job.attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
+		// _, e = tx.Exec("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (:1, :2, :3, :4, :5, NULL, :6, NULL, NULL, NULL, :7, :8)",
+		// 	job.priority, job.attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
+		// fmt.Println("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (:1, :2, :3, :4, :5, NULL, :6, NULL, NULL, NULL, :7, :8)",
+		// 	job.priority, job.attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
+		now_str := now.Format("2006-01-02 15:04:05")
+		// queue 和 handler_id 是调用者提供的， 必须作为参数传入
+		_, e = tx.Exec(fmt.Sprintf("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, run_at, created_at, updated_at) VALUES (%d, %d, '%d', %d, %d, :1, :2, :3, TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'))",
+			job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.run_at.Format("2006-01-02 15:04:05"), now_str, now_str), job.queue, handler, job.handler_id)
+		//fmt.Println(fmt.Sprintf("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, run_at, created_at, updated_at) VALUES (%d, %d, '%s', :1, '%s', TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'), TO_DATE('%s', 'YYYY-MM-DD HH24:MI:SS'))",
+		//	job.priority, job.attempts, job.queue, job.handler_id, job.run_at.Format("2006-01-02 15:04:05"), now_str, now_str), job.handler)
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		_, e = tx.Exec("DELETE FROM "+*table_name+" WHERE handler_id = $1", job.handler_id)
+		if nil != e {
+			break
 		}
+
+		_, e = tx.Exec("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULL, $9, NULL, NULL, NULL, $10, $11)",
+			job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, handler, job.handler_id, job.run_at, now, now)
+		// fmt.Println("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, NULL, $9, NULL, NULL, NULL, $10, $11)",
+		//	job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
+	default:
+		_, e = tx.Exec("DELETE FROM "+*table_name+" WHERE handler_id = ?", job.handler_id)
 		if nil != e {
-			return i18n(self.dbType, self.drv, e)
+			break
 		}
+
+		_, e = tx.Exec("INSERT INTO "+*table_name+"(priority, repeat_count, repeat_interval, attempts, max_attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, ?, ?, ?, NULL, ?, NULL, NULL, NULL, ?, ?)",
+			job.priority, job.repeat_count, job.repeat_interval, job.attempts, job.max_attempts, job.queue, handler, job.handler_id, job.run_at, now, now)
+		//fmt.Println("INSERT INTO "+*table_name+"(priority, attempts, queue, handler, handler_id, last_error, run_at, locked_at, locked_by, failed_at, created_at, updated_at) VALUES (?, ?, ?, ?, ?, NULL, ?, NULL, NULL, NULL, ?, ?)",
+		//	job.priority, job.attempts, job.queue, job.handler, job.handler_id, job.run_at, now, now)
+	}
+	if nil != e {
+		return i18n(self.dbType, self.drv, e)
 	}
 
-	isCommited = true
-	e = tx.Commit()
+	// handler_id 是唯一的， 用它取回新任务的 id
+	switch self.dbType {
+	case ORACLE, DM:
+		e = tx.QueryRow("SELECT id FROM "+*table_name+" WHERE handler_id = :1", job.handler_id).Scan(&job.id)
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		e = tx.QueryRow("SELECT id FROM "+*table_name+" WHERE handler_id = $1", job.handler_id).Scan(&job.id)
+	default:
+		e = tx.QueryRow("SELECT id FROM "+*table_name+" WHERE handler_id = ?", job.handler_id).Scan(&job.id)
+	}
 	if nil != e {
-		return errors.New("commit transaction failed, " + i18nString(self.dbType, self.drv, e))
+		return i18n(self.dbType, self.drv, e)
 	}
 	return nil
 }
 
 func (self *dbBackend) update(id int64, attributes map[string]interface{}) error {
+	_, e := self.updateWhere(id, attributes, "")
+	return e
+}
+
+// updateWhere 修改任务， condition 不为空时只修改满足它的任务， 返回修改的行数
+func (self *dbBackend) updateWhere(id int64, attributes map[string]interface{}, condition string) (int64, error) {
 	var buffer bytes.Buffer
 	params := make([]interface{}, 0, len(attributes))
 
@@ -696,17 +745,8 @@ func (self *dbBackend) update(id int64, attributes map[string]interface{}) error
 		if nil == v {
 			buffer.WriteString(" = NULL")
 		} else {
-			switch self.dbType {
-			case ORACLE, DM:
-				buffer.WriteString(" = :")
-				buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-			case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
-				buffer.WriteString(" = $")
-				buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-			default:
-				buffer.WriteString(" = ?")
-			}
-
+			buffer.WriteString(" = ")
+			buffer.WriteString(placeholders(self.dbType, len(params)+1, 1)[0])
 			params = append(params, v)
 		}
 	}
@@ -717,34 +757,31 @@ func (self *dbBackend) update(id int64, attributes map[string]interface{}) error
 		buffer.WriteString(", ")
 	}
 
-	switch self.dbType {
-	case ORACLE, DM:
-		buffer.WriteString("updated_at = :")
-		buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-		params = append(params, self.db_time_now())
-		buffer.WriteString(" WHERE id = :")
-		buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-		params = append(params, id)
-	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
-		buffer.WriteString("updated_at = $")
-		buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-		params = append(params, self.db_time_now())
-		buffer.WriteString(" WHERE id = $")
-		buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-		params = append(params, id)
-	default:
-		buffer.WriteString("updated_at = ?")
-		params = append(params, self.db_time_now())
-		buffer.WriteString(" WHERE id = ?")
-		params = append(params, id)
+	ss := placeholders(self.dbType, len(params)+1, 2)
+	buffer.WriteString("updated_at = ")
+	buffer.WriteString(ss[0])
+	buffer.WriteString(" WHERE id = ")
+	buffer.WriteString(ss[1])
+	params = append(params, self.db_time_now(), id)
+	if "" != condition {
+		buffer.WriteString(" AND (")
+		buffer.WriteString(condition)
+		buffer.WriteString(")")
 	}
 
 	//fmt.Println(buffer.String(), "\r\n", params)
-	_, e := self.db.Exec(buffer.String(), params...)
-	if nil != e && sql.ErrNoRows != e {
-		return i18n(self.dbType, self.drv, e)
+	result, e := self.db.Exec(buffer.String(), params...)
+	if nil != e {
+		if sql.ErrNoRows == e {
+			return 0, nil
+		}
+		return 0, i18n(self.dbType, self.drv, e)
 	}
-	return nil
+	affected, e := result.RowsAffected()
+	if nil != e {
+		return 0, i18n(self.dbType, self.drv, e)
+	}
+	return affected, nil
 }
 
 func (self *dbBackend) destroy(id int64) error {
@@ -765,12 +802,31 @@ func (self *dbBackend) destroy(id int64) error {
 	return nil
 }
 
-func buildSQL(dbType int, params map[string]interface{}) (string, []interface{}, error) {
+// placeholders 返回第 start 个开始的 n 个参数占位符
+func placeholders(dbType, start, n int) []string {
+	ss := make([]string, n)
+	for i := range ss {
+		switch dbType {
+		case ORACLE, DM:
+			ss[i] = ":" + strconv.Itoa(start+i)
+		case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+			ss[i] = "$" + strconv.Itoa(start+i)
+		default:
+			ss[i] = "?"
+		}
+	}
+	return ss
+}
+
+// buildSQL 返回查询 fields 的语句， params 中 '@' 开头的是条件， 还可以有 group_by, having, order_by, limit 和 offset
+func buildSQL(dbType int, fields string, params map[string]interface{}) (string, []interface{}, error) {
 	if nil == params || 0 == len(params) {
-		return "", []interface{}{}, nil
+		return "SELECT " + fields + " FROM " + *table_name, []interface{}{}, nil
 	}
 
 	buffer := bytes.NewBuffer(make([]byte, 0, 900))
+	buffer.WriteString(" FROM ")
+	buffer.WriteString(*table_name)
 	arguments := make([]interface{}, 0, len(params))
 	is_first := true
 	for k, v := range params {
@@ -780,7 +836,7 @@ func buildSQL(dbType int, params map[string]interface{}) (string, []interface{},
 		if is_first {
 			is_first = false
 			buffer.WriteString(" WHERE ")
-		} else if 0 != len(arguments) {
+		} else {
 			buffer.WriteString(" AND ")
 		}
 
@@ -795,17 +851,8 @@ func buildSQL(dbType int, params map[string]interface{}) (string, []interface{},
 			continue
 		}
 
-		switch dbType {
-		case ORACLE, DM:
-			buffer.WriteString(" = :")
-			buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-		case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
-			buffer.WriteString(" = $")
-			buffer.WriteString(strconv.FormatInt(int64(len(params)+1), 10))
-		default:
-			buffer.WriteString(" = ? ")
-		}
-
+		buffer.WriteString(" = ")
+		buffer.WriteString(placeholders(dbType, len(arguments)+1, 1)[0])
 		arguments = append(arguments, v)
 	}
 
@@ -855,50 +902,68 @@ func buildSQL(dbType int, params map[string]interface{}) (string, []interface{},
 		if nil == limit_v {
 			return "", nil, errors.New("limit is not a number, actual value is nil")
 		}
-		limit := fmt.Sprint(limit_v)
-		i, e := strconv.ParseInt(limit, 10, 64)
+		s := fmt.Sprint(limit_v)
+		limit, e := strconv.ParseInt(s, 10, 64)
 		if nil != e {
-			return "", nil, errors.New("limit is not a number, actual value is '" + limit + "'")
+			return "", nil, errors.New("limit is not a number, actual value is '" + s + "'")
 		}
-		if i <= 0 {
-			return "", nil, errors.New("limit must is geater zero, actual value is '" + limit + "'")
+		if limit <= 0 {
+			return "", nil, errors.New("limit must is geater zero, actual value is '" + s + "'")
 		}
 
+		offset := int64(0)
 		if offset_v, ok := params["offset"]; ok {
 			if nil == offset_v {
 				return "", nil, errors.New("offset is not a number, actual value is nil")
 			}
-			offset := fmt.Sprint(offset_v)
-			i, e = strconv.ParseInt(offset, 10, 64)
+			s = fmt.Sprint(offset_v)
+			offset, e = strconv.ParseInt(s, 10, 64)
 			if nil != e {
-				return "", nil, errors.New("offset is not a number, actual value is '" + offset + "'")
+				return "", nil, errors.New("offset is not a number, actual value is '" + s + "'")
 			}
 
-			if i < 0 {
-				return "", nil, errors.New("offset must is geater(or equals) zero, actual value is '" + offset + "'")
+			if offset < 0 {
+				return "", nil, errors.New("offset must is geater(or equals) zero, actual value is '" + s + "'")
 			}
+		}
 
-			buffer.WriteString(" LIMIT ")
-			buffer.WriteString(offset)
-			buffer.WriteString(" , ")
-			buffer.WriteString(limit)
-		} else {
-			buffer.WriteString(" LIMIT ")
-			buffer.WriteString(limit)
+		if _, ok := params["order_by"]; !ok && (MSSQL == dbType || ORACLE == dbType || SYBASE == dbType) {
+			buffer.WriteString(" ORDER BY id")
 		}
+		return pagingSQL(dbType, fields, buffer.String(), offset, limit), arguments, nil
 	}
 
-	return buffer.String(), arguments, nil
+	return "SELECT " + fields + buffer.String(), arguments, nil
+}
+
+// pagingSQL 返回分页的查询语句， from 是 FROM 及其后的子句， MSSQL 和 ORACLE 要求其中必须有 ORDER BY，
+// SYBASE 不支持 OFFSET， 它返回前 offset + limit 行， 调用者要跳过前 offset 行
+func pagingSQL(dbType int, fields, from string, offset, limit int64) string {
+	switch dbType {
+	case MSSQL:
+		return "SELECT " + fields + from + " OFFSET " + strconv.FormatInt(offset, 10) + " ROWS FETCH NEXT " + strconv.FormatInt(limit, 10) + " ROWS ONLY"
+	case SYBASE:
+		return "SELECT TOP " + strconv.FormatInt(offset+limit, 10) + " " + fields + from
+	case ORACLE:
+		// 老版本的 ORACLE 不支持 OFFSET， 只能用 ROWNUM
+		if 0 == offset {
+			return "SELECT * FROM (SELECT " + fields + from + ") WHERE ROWNUM <= " + strconv.FormatInt(limit, 10)
+		}
+		return "SELECT " + fields + " FROM (SELECT paging_t.*, ROWNUM AS paging_rn FROM (SELECT " + fields + from +
+			") paging_t WHERE ROWNUM <= " + strconv.FormatInt(offset+limit, 10) + ") WHERE paging_rn > " + strconv.FormatInt(offset, 10)
+	default:
+		return "SELECT " + fields + from + " LIMIT " + strconv.FormatInt(limit, 10) + " OFFSET " + strconv.FormatInt(offset, 10)
+	}
 }
 
 func (self *dbBackend) count(params map[string]interface{}) (int64, error) {
-	query, arguments, e := buildSQL(self.dbType, params)
+	query, arguments, e := buildSQL(self.dbType, "count(*)", params)
 	if nil != e {
 		return 0, e
 	}
 
 	count := int64(0)
-	e = self.db.QueryRow("SELECT count(*) FROM "+*table_name+query, arguments...).Scan(&count)
+	e = self.db.QueryRow(query, arguments...).Scan(&count)
 	if nil != e {
 		if sql.ErrNoRows == e {
 			return 0, nil
@@ -909,13 +974,36 @@ func (self *dbBackend) count(params map[string]interface{}) (int64, error) {
 }
 
 func (self *dbBackend) where(params map[string]interface{}) ([]map[string]interface{}, error) {
-	query, arguments, e := buildSQL(self.dbType, params)
+	query, arguments, e := buildSQL(self.dbType, fields_sql_string, params)
 	if nil != e {
 		return nil, i18n(self.dbType, self.drv, e)
 	}
 
-	//// fmt.Println(select_sql_string + query)
-	rows, e := self.db.Query(select_sql_string+query, arguments...)
+	//// fmt.Println(query)
+	results, e := self.queryJobs(query, arguments...)
+	if nil != e || SYBASE != self.dbType {
+		return results, e
+	}
+
+	// SYBASE 返回了前 offset + limit 行
+	if _, ok := params["limit"]; ok {
+		offset, _ := strconv.Atoi(fmt.Sprint(params["offset"]))
+		if offset >= len(results) {
+			return nil, nil
+		}
+		results = results[offset:]
+	}
+	return results, nil
+}
+
+// queryJobs 执行查询语句， 语句中的列必须和 fields_sql_string 一致
+func (self *dbBackend) queryJobs(query string, arguments ...interface{}) ([]map[string]interface{}, error) {
+	return self.queryJobsWith(true, query, arguments...)
+}
+
+// queryJobsWith 执行查询语句， redact 为 false 时返回数据库中原始的 handler 和 last_error
+func (self *dbBackend) queryJobsWith(redact bool, query string, arguments ...interface{}) ([]map[string]interface{}, error) {
+	rows, e := self.db.Query(query, arguments...)
 	if nil != e {
 		if sql.ErrNoRows == e {
 			return nil, nil
@@ -971,9 +1059,11 @@ func (self *dbBackend) where(params map[string]interface{}) ([]map[string]interf
 			"attempts":     attempts,
 			"max_attempts": max_attempts,
 			"handler":      handler,
-			"handler_id":   handler_id,
 			"created_at":   created_at,
 			"updated_at":   updated_at}
+		if redact {
+			result["handler"] = redactHandler(handler)
+		}
 
 		// var queue sql.NullString
 		// var last_error sql.NullString
@@ -988,8 +1078,14 @@ func (self *dbBackend) where(params map[string]interface{}) ([]map[string]interf
 		if repeat_interval.Valid {
 			result["repeat_interval"] = repeat_interval.String
 		}
+		if handler_id.Valid {
+			result["handler_id"] = handler_id.String
+		}
 
 		if last_error.Valid {
+			if redact {
+				last_error.String = redactText(last_error.String)
+			}
 			result["last_error"] = last_error.String
 			if 20 < len(last_error.String) {
 				result["last_error_summary"] = last_error.String[0:20] + "..."
@@ -1046,3 +1142,160 @@ func (self *dbBackend) where(params map[string]interface{}) ([]map[string]interf
 func (self *dbBackend) retry(id int64) error {
 	return self.update(id, map[string]interface{}{"@failed_at": nil})
 }
+
+type queueStat struct {
+	queue         string
+	count         int64
+	failed        int64
+	oldest_run_at time.Time
+}
+
+// queueStats 按队列统计任务数，和最早的可运行任务的 run_at
+func (self *dbBackend) queueStats() ([]queueStat, error) {
+	now_placeholder := placeholders(self.dbType, 1, 1)[0]
+
+	rows, e := self.db.Query("SELECT queue, "+
+		"SUM(CASE WHEN failed_at IS NULL THEN 1 ELSE 0 END), "+
+		"SUM(CASE WHEN failed_at IS NULL THEN 0 ELSE 1 END), "+
+		"MIN(CASE WHEN failed_at IS NULL AND run_at <= "+now_placeholder+" THEN run_at ELSE NULL END) "+
+		"FROM "+*table_name+" GROUP BY queue", self.db_time_now())
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	var results []queueStat
+	for rows.Next() {
+		var queue sql.NullString
+		var count sql.NullInt64
+		var failed sql.NullInt64
+		var oldest NullTime
+
+		e = rows.Scan(&queue, &count, &failed, &oldest)
+		if nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+
+		results = append(results, queueStat{queue: queue.String,
+			count:         count.Int64,
+			failed:        failed.Int64,
+			oldest_run_at: oldest.Time})
+	}
+
+	e = rows.Err()
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	return results, nil
+}
+
+// rotateKeys 用当前的主密钥重新加密所有任务的数据密钥， 返回更新的任务数
+func (self *dbBackend) rotateKeys() (int, error) {
+	rows, e := self.db.Query("SELECT id, handler FROM " + *table_name)
+	if nil != e {
+		return 0, i18n(self.dbType, self.drv, e)
+	}
+
+	changed := map[int64]string{}
+	for rows.Next() {
+		var id int64
+		var handler string
+		if e = rows.Scan(&id, &handler); nil != e {
+			rows.Close()
+			return 0, i18n(self.dbType, self.drv, e)
+		}
+
+		_, ok, e := rotateHandler(handler)
+		if nil != e {
+			rows.Close()
+			return 0, errors.New("rotate keys of job(" + strconv.FormatInt(id, 10) + ") failed, " + e.Error())
+		}
+		if ok {
+			changed[id] = handler
+		}
+	}
+	e = rows.Err()
+	rows.Close()
+	if nil != e {
+		return 0, i18n(self.dbType, self.drv, e)
+	}
+
+	count := 0
+	for id, handler := range changed {
+		ok, e := self.rotateJobKeys(id, handler)
+		if nil != e {
+			return count, e
+		}
+		if ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// rotateJobKeys 重新加密一个任务， 只有 handler 没有被修改时才更新， 被并发修改时重新读取后再试
+func (self *dbBackend) rotateJobKeys(id int64, handler string) (bool, error) {
+	for retries := 0; retries < 3; retries++ {
+		s, ok, e := rotateHandler(handler)
+		if nil != e {
+			return false, errors.New("rotate keys of job(" + strconv.FormatInt(id, 10) + ") failed, " + e.Error())
+		}
+		if !ok {
+			return false, nil
+		}
+
+		ph := placeholders(self.dbType, 1, 4)
+		old_handler := handler
+		handler_equals := "handler = " + ph[3]
+		switch self.dbType {
+		case ORACLE, DM:
+			handler_equals = "DBMS_LOB.COMPARE(handler, " + ph[3] + ") = 0"
+		case MSSQL:
+			handler_equals = "CAST(handler AS NVARCHAR(MAX)) = " + ph[3]
+		case SYBASE:
+			// text 类型不能用 '=' 比较
+			handler_equals = "handler LIKE " + ph[3] + likeEscape(self.dbType)
+			old_handler = escapeLike(self.dbType, handler)
+		}
+		result, e := self.db.Exec("UPDATE "+*table_name+" SET handler = "+ph[0]+", updated_at = "+ph[1]+
+			" WHERE id = "+ph[2]+" AND "+handler_equals, s, self.db_time_now(), id, old_handler)
+		if nil != e {
+			return false, i18n(self.dbType, self.drv, e)
+		}
+		affected, e := result.RowsAffected()
+		if nil != e {
+			return false, i18n(self.dbType, self.drv, e)
+		}
+		if affected > 0 {
+			return true, nil
+		}
+
+		e = self.db.QueryRow("SELECT handler FROM "+*table_name+" WHERE id = "+ph[0], id).Scan(&handler)
+		if nil != e {
+			if sql.ErrNoRows == e {
+				return false, nil
+			}
+			return false, i18n(self.dbType, self.drv, e)
+		}
+	}
+	return false, errors.New("rotate keys of job(" + strconv.FormatInt(id, 10) + ") failed, it is modified concurrently")
+}
+
+// escapeLike 转义 LIKE 中的通配符， 要与 likeEscape 一起使用， 只有 MSSQL 和 SYBASE 中的 [] 是通配符，
+// oracle、 DM 和 DB2 中转义其它字符会出错
+func escapeLike(dbType int, s string) string {
+	switch dbType {
+	case MSSQL, SYBASE:
+		return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_", "[", "\\[", "]", "\\]").Replace(s)
+	}
+	return strings.NewReplacer("\\", "\\\\", "%", "\\%", "_", "\\_").Replace(s)
+}
+
+// likeEscape 返回 LIKE 的 ESCAPE 子句， mysql 的字符串中 '\' 也是转义字符
+func likeEscape(dbType int) string {
+	switch dbType {
+	case MYSQL, MariaDB:
+		return " ESCAPE '\\\\'"
+	}
+	return " ESCAPE '\\'"
+}
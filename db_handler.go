@@ -430,7 +430,26 @@ func (self *dbHandler) Perform() (err error) {
 	return nil
 }
 
+func (self *dbHandler) Preview() (map[string]interface{}, error) {
+	return map[string]interface{}{"drv": self.drv,
+		"url":    redactText(self.urlStr),
+		"script": self.script}, nil
+}
+
+const db_schema = `{
+  "description": "execute the sql script",
+  "type": "object",
+  "required": ["drv", "url", "script"],
+  "properties": {
+    "drv": {"type": "string", "minLength": 1},
+    "url": {"type": "string", "minLength": 1},
+    "script": {"type": "string", "minLength": 1},
+    "arguments": {"description": "the values of the templates"}
+  }
+}`
+
 func init() {
 	Handlers["db"] = newDbHandler
 	Handlers["db_command"] = newDbHandler
+	RegisterHandlerSchema(db_schema, "db", "db_command")
 }
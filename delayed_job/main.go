@@ -3,6 +3,8 @@ package main
 import (
 	"flag"
 	"fmt"
+	"net/http"
+	"os"
 
 	"github.com/runner-mei/delayed_job"
 )
@@ -11,19 +13,38 @@ var (
 	db_url        = flag.String("db_url", "host=127.0.0.1 dbname=delayed_test user=delayedtest password=123456 sslmode=disable", "the db url")
 	db_drv        = flag.String("db_drv", "postgres", "the db driver")
 	listenAddress = flag.String("listen", ":37078", "the address of http")
-	run_mode      = flag.String("mode", "all", "init_db, console, backend, all")
+	run_mode      = flag.String("mode", "all", "init_db(drop and create all tables), migrate(create the missing tables and keep the jobs), console, backend, all, rotate-keys")
 )
 
 func main() {
+	flag.Usage = func() {
+		fmt.Fprintln(os.Stderr, "usage: "+os.Args[0]+" [options] [command [command options] [arguments]]")
+		fmt.Fprintln(os.Stderr, "options:")
+		flag.PrintDefaults()
+		delayed_job.PrintCommands(os.Stderr)
+	}
 	flag.Parse()
-	if nil != flag.Args() && 0 != len(flag.Args()) {
-		flag.Usage()
+
+	if 0 != flag.NArg() {
+		if !delayed_job.IsCommand(flag.Arg(0)) {
+			flag.Usage()
+			os.Exit(2)
+		}
+		e := delayed_job.RunCommand(*db_drv, *db_url, flag.Args(), os.Stdin, os.Stdout)
+		if nil != e {
+			fmt.Fprintln(os.Stderr, e)
+			os.Exit(1)
+		}
 		return
 	}
 
-	e := delayed_job.Main(db_drv, db_url, *listenAddress, *run_mode)
+	e := delayed_job.Main(*run_mode, *db_drv, *db_url, func(handler http.Handler) {
+		if e := delayed_job.ListenAndServe(*listenAddress, handler); nil != e {
+			fmt.Println(e)
+		}
+	})
 	if nil != e {
 		fmt.Println(e)
-		return
+		os.Exit(1)
 	}
 }
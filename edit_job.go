@@ -0,0 +1,186 @@
+package delayed_job
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+var (
+	errJobLocked      = errors.New("job is running, it can't be edited")
+	errNothingChanged = errors.New("nothing to update, handler, priority, queue, run_at, max_attempts or reset is required")
+)
+
+// invalidEditError 表示修改请求的内容不正确
+type invalidEditError struct {
+	error
+}
+
+// jobEdit 是修改任务的请求， 为空的字段不会被修改
+type jobEdit struct {
+	Handler     map[string]interface{} `json:"handler,omitempty"`
+	Priority    *int                   `json:"priority,omitempty"`
+	Queue       *string                `json:"queue,omitempty"`
+	RunAt       string                 `json:"run_at,omitempty"`
+	MaxAttempts *int                   `json:"max_attempts,omitempty"`
+
+	// Reset 为 true 时清除 attempts 和 failed_at， 任务会被重新执行
+	Reset bool `json:"reset,omitempty"`
+}
+
+// findJob 按 id 读取任务， 任务不存在时返回 nil
+func (self *dbBackend) findJob(id int64) (*Job, error) {
+	var rows *sql.Rows
+	var e error
+	switch self.dbType {
+	case ORACLE, DM:
+		rows, e = self.db.Query(select_sql_string+" WHERE id = :1", id)
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		rows, e = self.db.Query(select_sql_string+" WHERE id = $1", id)
+	default:
+		rows, e = self.db.Query(select_sql_string+" WHERE id = ?", id)
+	}
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	if !rows.Next() {
+		if e = rows.Err(); nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+		return nil, nil
+	}
+	return self.readJobFromRow(rows)
+}
+
+// changes 将修改请求转换为 update 的参数， handler 会用 newHandler 重新校验
+func (self *jobEdit) changes(job *Job) (map[string]interface{}, error) {
+	changed := map[string]interface{}{}
+
+	if nil != self.Handler {
+		// web ui 读到的 handler 中还有原来的 _encryption 和密文， 要去掉它们， 以便保存时重新加密
+		if e := decryptEdited(self.Handler, job.handler); nil != e {
+			return nil, errors.New("handler is invalid, " + e.Error())
+		}
+
+		// web ui 读到的 handler 中密码等字段已被屏蔽， 未修改的字段要还原为原来的值
+		if original, e := job.attributes(); nil == e {
+			unredactMap(self.Handler, original)
+		}
+
+		options := make(map[string]interface{}, len(self.Handler))
+		for k, v := range self.Handler {
+			options[k] = v
+		}
+		if _, e := newHandler(job.backend.ctx, options); nil != e {
+			return nil, errors.New("handler is invalid, " + e.Error())
+		}
+		changed["@handler"] = self.Handler
+		if e := stringifiedHander(changed); nil != e {
+			return nil, e
+		}
+	}
+	if nil != self.Priority {
+		changed["@priority"] = *self.Priority
+	}
+	if nil != self.Queue {
+		changed["@queue"] = *self.Queue
+	}
+	if "" != self.RunAt {
+		run_at, e := parseQueryTime(self.RunAt)
+		if nil != e {
+			return nil, errors.New("run_at is invalid, " + e.Error())
+		}
+		changed["@run_at"] = run_at
+	}
+	if nil != self.MaxAttempts {
+		if *self.MaxAttempts < 0 {
+			return nil, errors.New("max_attempts must is geater(or equals) zero")
+		}
+		changed["@max_attempts"] = *self.MaxAttempts
+	}
+	if self.Reset {
+		changed["@attempts"] = 0
+		changed["@failed_at"] = nil
+		changed["@locked_at"] = nil
+		changed["@locked_by"] = nil
+	}
+
+	if 0 == len(changed) {
+		return nil, errNothingChanged
+	}
+	return changed, nil
+}
+
+func (self *dbBackend) editJob(id int64, edit *jobEdit) error {
+	job, e := self.findJob(id)
+	if nil != e {
+		return e
+	}
+	if nil == job {
+		return errJobNotFound
+	}
+	if "" != job.locked_by && job.failed_at.IsZero() {
+		return errJobLocked
+	}
+
+	changed, e := edit.changes(job)
+	if nil != e {
+		return invalidEditError{e}
+	}
+
+	// 检查后任务可能被 worker 锁定了， 所以更新时再检查一次
+	affected, e := self.updateWhere(id, changed, "locked_by IS NULL OR failed_at IS NOT NULL")
+	if nil != e {
+		return e
+	}
+	if 0 != affected {
+		return nil
+	}
+
+	// 有的数据库在值没有改变时返回 0， 所以要重新读取任务确认是否被锁定了
+	job, e = self.findJob(id)
+	if nil != e {
+		return e
+	}
+	if nil == job {
+		return errJobNotFound
+	}
+	if "" != job.locked_by && job.failed_at.IsZero() {
+		return errJobLocked
+	}
+	return nil
+}
+
+func apiEditJob(w http.ResponseWriter, r *http.Request, backend *dbBackend, id int64) {
+	decoder := json.NewDecoder(r.Body)
+	decoder.UseNumber()
+	var edit jobEdit
+	if e := decoder.Decode(&edit); nil != e {
+		writeAPIErrorWithStatus(w, http.StatusBadRequest, e)
+		return
+	}
+
+	before := backend.jobSnapshot(id)
+	if e := backend.editJob(id, &edit); nil != e {
+		switch e {
+		case errJobNotFound:
+			writeAPIErrorWithStatus(w, http.StatusNotFound, e)
+		case errJobLocked:
+			writeAPIErrorWithStatus(w, http.StatusConflict, e)
+		default:
+			if _, ok := e.(invalidEditError); ok {
+				writeAPIErrorWithStatus(w, http.StatusUnprocessableEntity, e)
+			} else {
+				writeAPIErrorWithStatus(w, http.StatusInternalServerError, e)
+			}
+		}
+		return
+	}
+
+	after := backend.jobSnapshot(id)
+	backend.audit(r, "edit", id, before, after)
+	writeJSON(w, http.StatusOK, after)
+}
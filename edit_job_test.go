@@ -0,0 +1,166 @@
+package delayed_job
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestJobEditChanges(t *testing.T) {
+	job := &Job{}
+	if _, e := (&jobEdit{}).changes(job); errNothingChanged != e {
+		t.Error("excepted error is", errNothingChanged, ", actual is", e)
+	}
+	if _, e := (&jobEdit{RunAt: "abc"}).changes(job); nil == e {
+		t.Error("excepted error for the invalid run_at")
+	}
+
+	priority := 3
+	queue := "bb"
+	changed, e := (&jobEdit{Priority: &priority, Queue: &queue, RunAt: "2020-01-02 03:04:05", Reset: true}).changes(job)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if 3 != changed["@priority"] || "bb" != changed["@queue"] || 0 != changed["@attempts"] {
+		t.Error("changes is invalid -", changed)
+	}
+	if v, ok := changed["@failed_at"]; !ok || nil != v {
+		t.Error("failed_at isn't reset -", changed)
+	}
+	if run_at, _ := changed["@run_at"].(time.Time); 2020 != run_at.Year() {
+		t.Error("run_at is invalid -", changed["@run_at"])
+	}
+}
+
+func TestEditJob(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		job, e := newJob(backend, 1, 0, "", 0, "aa", time.Time{}, map[string]interface{}{"type": "test", "content": "hello"}, true)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if e = backend.create(job); nil != e {
+			t.Error(e)
+			return
+		}
+		if e = backend.update(job.id, map[string]interface{}{"@failed_at": time.Now(), "@attempts": 3, "@locked_by": "w1"}); nil != e {
+			t.Error(e)
+			return
+		}
+
+		e = backend.editJob(job.id, &jobEdit{Handler: map[string]interface{}{"type": "abc"}})
+		if _, ok := e.(invalidEditError); !ok {
+			t.Error("excepted error is invalid, actual is", e)
+		}
+
+		priority := 5
+		e = backend.editJob(job.id, &jobEdit{Handler: map[string]interface{}{"type": "test", "content": "world"},
+			Priority: &priority,
+			Reset:    true})
+		if nil != e {
+			t.Error(e)
+			return
+		}
+
+		edited, e := backend.findJob(job.id)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if 5 != edited.priority || 0 != edited.attempts || !edited.failed_at.IsZero() || "" != edited.locked_by {
+			t.Error("job isn't updated -", edited)
+		}
+		attributes, e := edited.attributes()
+		if nil != e {
+			t.Error(e)
+		} else if "world" != attributes["content"] {
+			t.Error("excepted content is world, actual is", attributes["content"])
+		}
+
+		if e = backend.update(job.id, map[string]interface{}{"@locked_by": "w1"}); nil != e {
+			t.Error(e)
+			return
+		}
+		if e = backend.editJob(job.id, &jobEdit{Priority: &priority}); errJobLocked != e {
+			t.Error("excepted error is", errJobLocked, ", actual is", e)
+		}
+
+		// 任务在检查后被锁定时不会被修改
+		affected, e := backend.updateWhere(job.id, map[string]interface{}{"@priority": 9}, "locked_by IS NULL OR failed_at IS NOT NULL")
+		if nil != e {
+			t.Error(e)
+		} else if 0 != affected {
+			t.Error("locked job is updated")
+		}
+
+		if e = backend.editJob(job.id+1000, &jobEdit{Priority: &priority}); errJobNotFound != e {
+			t.Error("excepted error is", errJobNotFound, ", actual is", e)
+		}
+	})
+}
+
+func TestEditJobWithSecrets(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	old := *secrets_fields
+	*secrets_fields = "api_key"
+	defer func() { *secrets_fields = old }()
+
+	backendTest(t, func(backend *dbBackend) {
+		withMasterKeys(t, "k1:"+key1, func() {
+			job, e := newJob(backend, 1, 0, "", 0, "aa", time.Time{}, map[string]interface{}{"type": "test",
+				"password": "abc", "api_key": "xyz", "content": "hello"}, true)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			if e = backend.create(job); nil != e {
+				t.Error(e)
+				return
+			}
+
+			// 模拟 web ui， 它读到的是屏蔽后的 handler， 修改后原样提交
+			stored, e := backend.findJob(job.id)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			handler, e := decodeHandler(redactHandler(stored.handler))
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			handler["content"] = "world"
+			if e = backend.editJob(job.id, &jobEdit{Handler: handler}); nil != e {
+				t.Error(e)
+				return
+			}
+
+			edited, e := backend.findJob(job.id)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			raw, e := decodeHandler(edited.handler)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			for _, name := range []string{"password", "api_key"} {
+				if s, _ := raw[name].(string); !strings.HasPrefix(s, encrypted_prefix) {
+					t.Error(name, "is saved in plaintext -", edited.handler)
+				}
+			}
+
+			attributes, e := edited.attributes()
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			if "abc" != attributes["password"] || "xyz" != attributes["api_key"] || "world" != attributes["content"] {
+				t.Error("handler is invalid -", attributes)
+			}
+		})
+	})
+}
@@ -0,0 +1,226 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+var (
+	events_history   = flag.Int("events.history", 1000, "the number of recent job events kept for the reconnected clients of /events, the events of the workers are only published when the workers run in the same process('-mode all')")
+	events_buffer    = flag.Int("events.buffer", 256, "the buffer size of each client of /events, events are dropped when it is full")
+	events_heartbeat = flag.Duration("events.heartbeat", 15*time.Second, "the interval of the heartbeat comments of /events")
+)
+
+// 任务的生命周期事件
+const (
+	event_created   = "created"
+	event_reserved  = "reserved"
+	event_succeeded = "succeeded"
+	event_failed    = "failed"
+	event_retried   = "retried"
+	event_deleted   = "deleted"
+)
+
+type jobEvent struct {
+	Id      int64     `json:"id"`
+	Event   string    `json:"event"`
+	JobId   int64     `json:"job_id"`
+	Type    string    `json:"type,omitempty"`
+	Queue   string    `json:"queue,omitempty"`
+	Attempt int       `json:"attempt,omitempty"`
+	Error   string    `json:"error,omitempty"`
+	Time    time.Time `json:"time"`
+}
+
+// eventBus 将事件分发给当前进程中所有的订阅者， 并保留最近的事件用于断线重连，
+// 事件不会在进程间传递， 所以 reserved, succeeded, failed 等 worker 的事件只在 '-mode all' 时才有
+type eventBus struct {
+	lock        sync.Mutex
+	seq         int64
+	history     []*jobEvent
+	subscribers map[chan *jobEvent]struct{}
+}
+
+var job_events = &eventBus{subscribers: map[chan *jobEvent]struct{}{}}
+
+func (self *eventBus) publish(ev *jobEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	self.seq++
+	ev.Id = self.seq
+	if ev.Time.IsZero() {
+		ev.Time = time.Now()
+	}
+
+	if *events_history > 0 {
+		if len(self.history) >= *events_history {
+			self.history = append(self.history[:0], self.history[len(self.history)-*events_history+1:]...)
+		}
+		self.history = append(self.history, ev)
+	}
+
+	for ch := range self.subscribers {
+		select {
+		case ch <- ev:
+		default:
+			// 客户端太慢， 丢弃事件
+		}
+	}
+}
+
+// subscribe 订阅事件， 同时返回 last_id 之后的历史事件
+func (self *eventBus) subscribe(last_id int64) (chan *jobEvent, []*jobEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+
+	var missed []*jobEvent
+	if last_id > 0 {
+		for _, ev := range self.history {
+			if ev.Id > last_id {
+				missed = append(missed, ev)
+			}
+		}
+	}
+
+	ch := make(chan *jobEvent, *events_buffer)
+	self.subscribers[ch] = struct{}{}
+	return ch, missed
+}
+
+func (self *eventBus) unsubscribe(ch chan *jobEvent) {
+	self.lock.Lock()
+	defer self.lock.Unlock()
+	delete(self.subscribers, ch)
+}
+
+func publishJobEvent(event string, job *Job, e error) {
+	ev := &jobEvent{Event: event,
+		JobId:   job.id,
+		Type:    job.handlerType(),
+		Queue:   job.queue,
+		Attempt: job.attempts}
+	if nil != e {
+		ev.Error = redactText(e.Error())
+	}
+	job_events.publish(ev)
+}
+
+// publishSnapshotEvent 用 jobSnapshot 的结果发布事件
+func publishSnapshotEvent(event string, snapshot interface{}) {
+	m, ok := snapshot.(map[string]interface{})
+	if !ok {
+		return
+	}
+	ev := &jobEvent{Event: event}
+	ev.JobId, _ = m["id"].(int64)
+	ev.Queue, _ = m["queue"].(string)
+	ev.Attempt, _ = m["attempts"].(int)
+	if handler, ok := m["handler"].(string); ok {
+		var attributes map[string]interface{}
+		if nil == json.Unmarshal([]byte(handler), &attributes) {
+			ev.Type = stringWithDefault(attributes, "type", "")
+		}
+	}
+	job_events.publish(ev)
+}
+
+type eventFilter struct {
+	queues map[string]bool
+	events map[string]bool
+}
+
+func splitFilter(s string) map[string]bool {
+	if "" == s {
+		return nil
+	}
+	m := map[string]bool{}
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); "" != v {
+			m[v] = true
+		}
+	}
+	return m
+}
+
+func (self *eventFilter) match(ev *jobEvent) bool {
+	if nil != self.queues && !self.queues[ev.Queue] {
+		return false
+	}
+	if nil != self.events && !self.events[ev.Event] {
+		return false
+	}
+	return true
+}
+
+func writeEvent(w io.Writer, ev *jobEvent) error {
+	bs, e := json.Marshal(ev)
+	if nil != e {
+		return e
+	}
+	_, e = io.WriteString(w, "id: "+strconv.FormatInt(ev.Id, 10)+"\nevent: "+ev.Event+"\ndata: "+string(bs)+"\n\n")
+	return e
+}
+
+// eventsHandler 以 Server-Sent Events 的方式推送任务事件， 可以用 queue 和 event 参数过滤，
+// 多个值用 ',' 分隔， 它只能推送本进程中的事件， worker 运行在其它进程中时只有 http 接口产生的事件
+func eventsHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, "streaming is unsupported")
+		return
+	}
+
+	filter := &eventFilter{queues: splitFilter(r.URL.Query().Get("queue")),
+		events: splitFilter(r.URL.Query().Get("event"))}
+
+	last_id, _ := strconv.ParseInt(r.Header.Get("Last-Event-ID"), 10, 64)
+	ch, missed := job_events.subscribe(last_id)
+	defer job_events.unsubscribe(ch)
+
+	w.Header().Set("Content-Type", "text/event-stream; charset=utf-8")
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	io.WriteString(w, "retry: 3000\n\n")
+
+	for _, ev := range missed {
+		if filter.match(ev) {
+			if e := writeEvent(w, ev); nil != e {
+				return
+			}
+		}
+	}
+	flusher.Flush()
+
+	ticker := time.NewTicker(*events_heartbeat)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-ticker.C:
+			if _, e := io.WriteString(w, ": heartbeat\n\n"); nil != e {
+				return
+			}
+			flusher.Flush()
+		case ev := <-ch:
+			if !filter.match(ev) {
+				continue
+			}
+			if e := writeEvent(w, ev); nil != e {
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
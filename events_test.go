@@ -0,0 +1,142 @@
+package delayed_job
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventBus(t *testing.T) {
+	bus := &eventBus{subscribers: map[chan *jobEvent]struct{}{}}
+	bus.publish(&jobEvent{Event: event_created, JobId: 1})
+
+	ch, missed := bus.subscribe(0)
+	if 0 != len(missed) {
+		t.Error("excepted missed is empty, actual is", missed)
+	}
+	bus.publish(&jobEvent{Event: event_reserved, JobId: 1, Queue: "aa"})
+
+	select {
+	case ev := <-ch:
+		if 2 != ev.Id || event_reserved != ev.Event || ev.Time.IsZero() {
+			t.Error("event is invalid -", ev)
+		}
+	default:
+		t.Error("event isn't received")
+	}
+	bus.unsubscribe(ch)
+
+	ch, missed = bus.subscribe(1)
+	defer bus.unsubscribe(ch)
+	if 1 != len(missed) || 2 != missed[0].Id {
+		t.Error("missed is invalid -", missed)
+	}
+}
+
+func TestEventBusHistory(t *testing.T) {
+	old := *events_history
+	*events_history = 3
+	defer func() {
+		*events_history = old
+	}()
+
+	bus := &eventBus{subscribers: map[chan *jobEvent]struct{}{}}
+	for i := 0; i < 10; i++ {
+		bus.publish(&jobEvent{Event: event_created, JobId: int64(i)})
+	}
+	if 3 != len(bus.history) || 8 != bus.history[0].Id || 10 != bus.history[2].Id {
+		t.Error("history is invalid -", bus.history)
+	}
+}
+
+func TestEventFilter(t *testing.T) {
+	filter := &eventFilter{queues: splitFilter("aa, bb"), events: splitFilter("failed")}
+	for _, test := range []struct {
+		ev       *jobEvent
+		excepted bool
+	}{{&jobEvent{Event: event_failed, Queue: "aa"}, true},
+		{&jobEvent{Event: event_failed, Queue: "bb"}, true},
+		{&jobEvent{Event: event_failed, Queue: "cc"}, false},
+		{&jobEvent{Event: event_created, Queue: "aa"}, false}} {
+		if test.excepted != filter.match(test.ev) {
+			t.Error("excepted match is", test.excepted, "for", test.ev)
+		}
+	}
+
+	if !(&eventFilter{}).match(&jobEvent{Event: event_created}) {
+		t.Error("excepted empty filter matches all")
+	}
+}
+
+func TestPublishSnapshotEvent(t *testing.T) {
+	ch, _ := job_events.subscribe(0)
+	defer job_events.unsubscribe(ch)
+
+	publishSnapshotEvent(event_deleted, map[string]interface{}{"id": int64(12),
+		"queue":    "aa",
+		"attempts": 2,
+		"handler":  "{\n  \"type\": \"test\"\n}"})
+
+	select {
+	case ev := <-ch:
+		if event_deleted != ev.Event || 12 != ev.JobId || "aa" != ev.Queue || "test" != ev.Type || 2 != ev.Attempt {
+			t.Error("event is invalid -", ev)
+		}
+	case <-time.After(time.Second):
+		t.Error("event isn't received")
+	}
+}
+
+func TestEventsHandler(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		eventsHandler(w, r, nil)
+	}))
+	defer srv.Close()
+
+	req, e := http.NewRequest("GET", srv.URL+"/events?queue=aa", nil)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	res, e := http.DefaultClient.Do(req.WithContext(ctx))
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	defer res.Body.Close()
+
+	if !strings.HasPrefix(res.Header.Get("Content-Type"), "text/event-stream") {
+		t.Error("excepted content type is text/event-stream, actual is", res.Header.Get("Content-Type"))
+	}
+
+	job_events.publish(&jobEvent{Event: event_created, JobId: 1, Queue: "bb"})
+	job_events.publish(&jobEvent{Event: event_failed, JobId: 2, Queue: "aa", Error: "timeout"})
+
+	reader := bufio.NewReader(res.Body)
+	var lines []string
+	for {
+		line, e := reader.ReadString('\n')
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if strings.HasPrefix(line, "data: ") {
+			lines = append(lines, line)
+			break
+		}
+		if strings.HasPrefix(line, "event: ") {
+			lines = append(lines, line)
+		}
+	}
+
+	if 2 != len(lines) || "event: failed\n" != lines[0] || !strings.Contains(lines[1], `"job_id":2`) {
+		t.Error("stream is invalid -", lines)
+	}
+}
@@ -3,15 +3,17 @@ package delayed_job
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"os"
 	"os/exec"
 	"path/filepath"
 	"runtime"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -26,12 +28,6 @@ var IsDebug = false
 var logCmdOutput = os.Getenv("tpt_delayed_object_log_cmd_output") == "true"
 var default_directory = flag.String("exec.directory", ".", "the work directory for execute")
 
-var testLogger *log.Logger
-
-func SetTestLogger(logger *log.Logger) {
-	testLogger = logger
-}
-
 type execHandler struct {
 	work_directory string
 	prompt         string
@@ -187,7 +183,7 @@ var ExecutableFolder string
 func init() {
 	executableFolder, e := osext.ExecutableFolder()
 	if nil != e {
-		fmt.Println("[warn]", e)
+		logger.Warn("read executable folder failed", "error", e)
 		return
 	}
 
@@ -240,6 +236,11 @@ func lookPath(executableFolder string, alias ...string) (string, bool) {
 }
 
 func (self *execHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *execHandler) PerformContext(ctx context.Context) error {
+	l := loggerFrom(ctx).With(slog.String("handler", "exec"))
 	if "tpt" == self.command || "tpt.exe" == self.command {
 		if a, ok := lookPath(ExecutableFolder, "tpt"); ok {
 			self.command = a
@@ -254,7 +255,7 @@ func (self *execHandler) Perform() error {
 		}
 	}
 
-	fmt.Println(self.command, self.arguments)
+	l.Info("execute command", "command", self.command, "arguments", self.arguments)
 	cmd := exec.Command(self.command, self.arguments...)
 	cmd.Dir = self.work_directory
 
@@ -314,7 +315,7 @@ func (self *execHandler) Perform() error {
 				if output == "" {
 					output = buffer.String()
 				}
-				fmt.Println(output)
+				l.Info("command output", "output", output)
 			}
 			return nil
 		}
@@ -347,28 +348,15 @@ func (self *execHandler) Perform() error {
 		for scanner.Scan() {
 			if strings.Contains(scanner.Text(), self.prompt) {
 				if bytes.Contains(scanner.Bytes(), []byte("[sms]")) {
-					if smsLogger != nil {
-						smsLogger.Println(string(scanner.Bytes()))
-					} else {
-						fmt.Println("[smslogger ok]", string(scanner.Bytes()))
-					}
+					l.Info("sms output", "output", scanner.Text())
 				} else {
-					if testLogger != nil {
-						testLogger.Println("[execlogger ok]", string(scanner.Bytes()))
-					}
-					// fmt.Println("[execlogger ok]", string(scanner.Bytes()))
+					l.Debug("command output matched prompt", "output", scanner.Text())
 				}
 				return
 			}
 
 			if bytes.Contains(scanner.Bytes(), []byte("[sms]")) {
-				if smsLogger != nil {
-					smsLogger.Println(string(scanner.Bytes()))
-				} else {
-					fmt.Println("[smslogger null]", string(scanner.Bytes()))
-				}
-				// } else {
-				// 	fmt.Println("[execlogger mismatch]", string(scanner.Bytes()))
+				l.Info("sms output", "output", scanner.Text())
 			}
 
 			buffer.Write(scanner.Bytes())
@@ -422,9 +410,75 @@ func (self *execHandler) Perform() error {
 	return scanError
 }
 
+// Preview 返回将要执行的命令行， 命令不会被执行
+func (self *execHandler) Preview() (map[string]interface{}, error) {
+	command := self.command
+	if "tpt" == command || "tpt.exe" == command {
+		if a, ok := lookPath(ExecutableFolder, "tpt"); ok {
+			command = a
+		}
+	} else if a, ok := lookPath(ExecutableFolder, command); ok {
+		command = a
+	}
+
+	environments := make([]string, 0, len(self.environments))
+	for _, s := range self.environments {
+		environments = append(environments, redactText(s))
+	}
+
+	var buffer bytes.Buffer
+	for idx, s := range append([]string{command}, self.arguments...) {
+		if idx > 0 {
+			buffer.WriteString(" ")
+		}
+		if "" == s || strings.ContainsAny(s, " \t\"'") {
+			buffer.WriteString(strconv.Quote(s))
+		} else {
+			buffer.WriteString(s)
+		}
+	}
+
+	return map[string]interface{}{"work_directory": self.work_directory,
+		"command":      command,
+		"arguments":    self.arguments,
+		"environments": environments,
+		"prompt":       self.prompt,
+		"command_line": buffer.String()}, nil
+}
+
+const exec_schema = `{
+  "description": "execute the command",
+  "type": "object",
+  "required": ["command"],
+  "properties": {
+    "command": {"type": "string", "minLength": 1, "description": "the command line, it is split to the arguments if command_arguments is missing"},
+    "command_arguments": {"type": "array"},
+    "work_directory": {"type": "string"},
+    "prompt": {"type": "string"},
+    "environments": {"type": ["string", "array"], "description": "the environments separated by ';'"},
+    "arguments": {"description": "the values of the templates"}
+  }
+}`
+
+const exec2_schema = `{
+  "description": "execute the command with the arguments",
+  "type": "object",
+  "required": ["command"],
+  "properties": {
+    "command": {"type": "string", "minLength": 1},
+    "arguments": {"type": ["array", "string"]},
+    "work_directory": {"type": "string"},
+    "prompt": {"type": "string"},
+    "environments": {"type": ["string", "array"], "description": "the environments separated by ';'"},
+    "options": {"type": "object"}
+  }
+}`
+
 func init() {
 	Handlers["exec"] = newExecHandler
 	Handlers["exec_command"] = newExecHandler
 	Handlers["exec2"] = newExecHandler2
 	Handlers["exec2_command"] = newExecHandler2
+	RegisterHandlerSchema(exec_schema, "exec", "exec_command")
+	RegisterHandlerSchema(exec2_schema, "exec2", "exec2_command")
 }
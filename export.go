@@ -0,0 +1,382 @@
+package delayed_job
+
+import (
+	"bufio"
+	"bytes"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const max_import_line_size = 16 * 1024 * 1024
+
+// exportRecord 是导出文件中的一行， 包含任务的所有字段， handler 是 json 对象
+type exportRecord struct {
+	Id             int64           `json:"id"`
+	Priority       int             `json:"priority"`
+	RepeatCount    int             `json:"repeat_count"`
+	RepeatInterval string          `json:"repeat_interval,omitempty"`
+	Attempts       int             `json:"attempts"`
+	MaxAttempts    int             `json:"max_attempts"`
+	Queue          string          `json:"queue,omitempty"`
+	Handler        json.RawMessage `json:"handler"`
+	HandlerId      string          `json:"handler_id,omitempty"`
+	LastError      string          `json:"last_error,omitempty"`
+	RunAt          *time.Time      `json:"run_at,omitempty"`
+	LockedAt       *time.Time      `json:"locked_at,omitempty"`
+	LockedBy       string          `json:"locked_by,omitempty"`
+	FailedAt       *time.Time      `json:"failed_at,omitempty"`
+	CreatedAt      *time.Time      `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time      `json:"updated_at,omitempty"`
+}
+
+// newExportRecord 将 queryJobsWith(false, ...) 返回的任务转换为导出的记录，
+// decrypt 为 false 时 handler 中加密的字段保持加密， 导入时需要相同的主密钥
+func newExportRecord(job map[string]interface{}, decrypt bool) (*exportRecord, error) {
+	record := &exportRecord{Id: job["id"].(int64),
+		Priority:    job["priority"].(int),
+		RepeatCount: job["repeat_count"].(int),
+		Attempts:    job["attempts"].(int),
+		MaxAttempts: job["max_attempts"].(int)}
+	record.RepeatInterval, _ = job["repeat_interval"].(string)
+	record.Queue, _ = job["queue"].(string)
+	record.HandlerId, _ = job["handler_id"].(string)
+	record.LastError, _ = job["last_error"].(string)
+	record.LockedBy, _ = job["locked_by"].(string)
+
+	for _, field := range []struct {
+		key   string
+		value **time.Time
+	}{{"run_at", &record.RunAt},
+		{"locked_at", &record.LockedAt},
+		{"failed_at", &record.FailedAt},
+		{"created_at", &record.CreatedAt},
+		{"updated_at", &record.UpdatedAt}} {
+		if t, ok := job[field.key].(time.Time); ok {
+			*field.value = &t
+		}
+	}
+
+	attributes, e := decodeHandler(job["handler"].(string))
+	if nil != e {
+		return nil, deserializationError(e)
+	}
+	if decrypt {
+		if attributes, e = decryptAttributes(attributes); nil != e {
+			return nil, e
+		}
+	} else {
+		record.LastError = redactText(record.LastError)
+	}
+	s, e := encodeHandler(attributes)
+	if nil != e {
+		return nil, e
+	}
+	record.Handler = json.RawMessage(s)
+	return record, nil
+}
+
+// attributes 返回 handler， 也兼容 handler 是字符串的旧格式
+func (self *exportRecord) attributes() (map[string]interface{}, error) {
+	raw := bytes.TrimSpace(self.Handler)
+	if 0 == len(raw) {
+		return nil, errors.New("handler is required")
+	}
+	txt := string(raw)
+	if '"' == raw[0] {
+		if e := json.Unmarshal(raw, &txt); nil != e {
+			return nil, errors.New("handler is invalid, " + e.Error())
+		}
+	}
+	attributes, e := decodeHandler(txt)
+	if nil != e {
+		return nil, errors.New("handler is invalid, " + e.Error())
+	}
+	if nil == attributes {
+		return nil, errors.New("handler is required")
+	}
+	if "" == stringWithDefault(attributes, "type", "") {
+		return nil, errors.New("handler type is required")
+	}
+	if _, ok := attributes[encryption_key]; ok {
+		// 加密的 handler 原样导入， 这里检查一下是否有对应的主密钥， 以免任务执行时才失败
+		if _, _, e := unwrapDataKey(attributes); nil != e {
+			return nil, errors.New("handler can't be decrypted, " + e.Error())
+		}
+	}
+	return attributes, nil
+}
+
+// job 将导入的记录转换为任务， 任务的 id 由数据库重新生成， locked_at 和 locked_by 会被忽略
+func (self *exportRecord) job(backend *dbBackend) (*Job, error) {
+	attributes, e := self.attributes()
+	if nil != e {
+		return nil, e
+	}
+
+	handler_id := self.HandlerId
+	if "" == handler_id {
+		handler_id = stringWithDefault(attributes, "_uid", "")
+		if "" == handler_id {
+			handler_id = generate_id()
+		}
+	}
+
+	s, e := json.MarshalIndent(attributes, "", "  ")
+	if nil != e {
+		return nil, deserializationError(e)
+	}
+
+	job := &Job{backend: backend,
+		priority:           self.Priority,
+		repeat_count:       self.RepeatCount,
+		repeat_interval:    self.RepeatInterval,
+		attempts:           self.Attempts,
+		max_attempts:       self.MaxAttempts,
+		queue:              self.Queue,
+		handler:            string(s),
+		handler_id:         handler_id,
+		last_error:         self.LastError,
+		handler_attributes: attributes}
+	if nil != self.RunAt {
+		job.run_at = *self.RunAt
+	}
+	if nil != self.FailedAt {
+		job.failed_at = *self.FailedAt
+	}
+	if nil != self.CreatedAt {
+		job.created_at = *self.CreatedAt
+	}
+	return job, nil
+}
+
+// exportJobs 将符合条件的任务按 id 的顺序以 json lines 的格式写到 w 中， 返回导出的任务数，
+// decrypt 为 true 时解密 handler 中的密码等字段
+func (self *dbBackend) exportJobs(q *jobQuery, decrypt bool, w io.Writer) (int, error) {
+	q.sort_by = "id"
+	q.desc = false
+	q.cursor = nil
+	q.limit = max_page_size
+
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+
+	count := 0
+	for {
+		query, arguments := q.build(self.dbType)
+		jobs, e := self.queryJobsWith(false, query, arguments...)
+		if nil != e {
+			return count, e
+		}
+		has_more := len(jobs) > q.limit
+		if has_more {
+			jobs = jobs[:q.limit]
+		}
+
+		for _, job := range jobs {
+			record, e := newExportRecord(job, decrypt)
+			if nil != e {
+				return count, errors.New("export job(" + strconv.FormatInt(job["id"].(int64), 10) + ") failed, " + e.Error())
+			}
+			if e = encoder.Encode(record); nil != e {
+				return count, e
+			}
+			count++
+		}
+
+		if !has_more {
+			return count, nil
+		}
+		q.cursor = &jobCursor{Id: jobs[len(jobs)-1]["id"].(int64)}
+	}
+}
+
+// importJobs 读取 exportJobs 导出的任务， 每 batch 个任务在一个事务中创建， 返回导入的任务数，
+// handler_id 保持不变， 所以已存在的相同任务会被替换
+func (self *dbBackend) importJobs(r io.Reader, batch int) (int, error) {
+	if batch <= 0 {
+		batch = *bulk_batch_size
+	}
+
+	count := 0
+	var jobs []*Job
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), max_import_line_size)
+	line := 0
+	for scanner.Scan() {
+		line++
+		bs := bytes.TrimSpace(scanner.Bytes())
+		if 0 == len(bs) {
+			continue
+		}
+
+		var record exportRecord
+		if e := json.Unmarshal(bs, &record); nil != e {
+			return count, errors.New("line " + strconv.Itoa(line) + " is invalid, " + e.Error())
+		}
+		job, e := record.job(self)
+		if nil != e {
+			return count, errors.New("line " + strconv.Itoa(line) + " is invalid, " + e.Error())
+		}
+
+		jobs = append(jobs, job)
+		if len(jobs) >= batch {
+			if e := self.importBatch(jobs); nil != e {
+				return count, errors.New("import jobs before line " + strconv.Itoa(line+1) + " failed, " + e.Error())
+			}
+			count += len(jobs)
+			jobs = nil
+		}
+	}
+	if e := scanner.Err(); nil != e {
+		return count, errors.New("read line " + strconv.Itoa(line+1) + " failed, " + e.Error())
+	}
+
+	if 0 != len(jobs) {
+		if e := self.importBatch(jobs); nil != e {
+			return count, errors.New("import jobs before line " + strconv.Itoa(line+1) + " failed, " + e.Error())
+		}
+		count += len(jobs)
+	}
+	return count, nil
+}
+
+func (self *dbBackend) importBatch(jobs []*Job) (e error) {
+	now := self.db_time_now()
+
+	tx, e := self.db.Begin()
+	if nil != e {
+		return errors.New("open transaction failed, " + i18nString(self.dbType, self.drv, e))
+	}
+	isCommited := false
+	defer func() {
+		if !isCommited {
+			err := tx.Rollback()
+			if nil == e {
+				e = errors.New("rollback transaction failed, " + i18nString(self.dbType, self.drv, err))
+			}
+		}
+	}()
+
+	for _, job := range jobs {
+		if e = self.insertJob(tx, job, now); nil != e {
+			return e
+		}
+		if e = self.restoreJob(tx, job); nil != e {
+			return e
+		}
+	}
+
+	isCommited = true
+	e = tx.Commit()
+	if nil != e {
+		return errors.New("commit transaction failed, " + i18nString(self.dbType, self.drv, e))
+	}
+
+	for _, job := range jobs {
+		metrics_jobs_enqueued.Inc(job.handlerType(), job.queue)
+		publishJobEvent(event_created, job, nil)
+	}
+	return nil
+}
+
+// restoreJob 恢复 insertJob 不会保存的字段
+func (self *dbBackend) restoreJob(tx *sql.Tx, job *Job) error {
+	var columns []string
+	var arguments []interface{}
+	set := func(column string, value interface{}) {
+		arguments = append(arguments, value)
+		columns = append(columns, column+" = "+placeholders(self.dbType, len(arguments), 1)[0])
+	}
+
+	if "" != job.last_error {
+		set("last_error", job.last_error)
+	}
+	if !job.failed_at.IsZero() {
+		set("failed_at", job.failed_at)
+	}
+	if !job.created_at.IsZero() {
+		set("created_at", job.created_at)
+	}
+	if 0 == len(columns) {
+		return nil
+	}
+
+	arguments = append(arguments, job.id)
+	_, e := tx.Exec("UPDATE "+*table_name+" SET "+strings.Join(columns, ", ")+
+		" WHERE id = "+placeholders(self.dbType, len(arguments), 1)[0], arguments...)
+	if nil != e {
+		return i18n(self.dbType, self.drv, e)
+	}
+	return nil
+}
+
+func exportHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	values := r.URL.Query()
+	decrypt := "true" == values.Get("decrypt")
+	for _, key := range []string{"decrypt", "sort", "limit", "cursor"} {
+		values.Del(key)
+	}
+	q, e := parseJobQuery(values, 0)
+	if nil != e {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	// 导出开始后就不能再修改状态码了， 出错时通过 trailer 通知客户端
+	w.Header().Set("Trailer", "X-Export-Error")
+	w.Header()["Content-Type"] = []string{"application/x-ndjson; charset=utf-8"}
+	w.Header().Set("Content-Disposition", `attachment; filename="jobs.jsonl"`)
+	count, e := backend.exportJobs(q, decrypt, w)
+	backend.audit(r, "export", 0, map[string]interface{}{"query": r.URL.RawQuery}, map[string]interface{}{"exported": count})
+	if nil != e {
+		loggerFrom(r.Context()).Warn("export jobs failed", "exported", count, "error", e)
+		if 0 == count {
+			w.Header().Del("Trailer")
+			w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+			w.Header().Del("Content-Disposition")
+			w.WriteHeader(http.StatusInternalServerError)
+			io.WriteString(w, e.Error())
+			return
+		}
+		w.Header().Set("X-Export-Error", e.Error())
+	}
+}
+
+func importHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	batch := *bulk_batch_size
+	if s := r.URL.Query().Get("batch"); "" != s {
+		i, e := strconv.Atoi(s)
+		if nil != e || i <= 0 {
+			w.WriteHeader(http.StatusBadRequest)
+			io.WriteString(w, "batch must is a number and geater zero, actual value is '"+s+"'")
+			return
+		}
+		batch = i
+	}
+
+	count, e := backend.importJobs(r.Body, batch)
+	result := map[string]interface{}{"imported": count}
+	backend.audit(r, "import", 0, nil, result)
+	if nil != e {
+		loggerFrom(r.Context()).Warn("import jobs failed", "imported", count, "error", e)
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, strconv.Itoa(count)+" jobs are imported, "+e.Error())
+		return
+	}
+
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	e = json.NewEncoder(w).Encode(result)
+	if nil != e {
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+}
@@ -0,0 +1,167 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestExportRecord(t *testing.T) {
+	for _, s := range []string{`{"handler": {"type": "test", "_uid": "h1", "count": 1}}`,
+		`{"handler": "{\"type\": \"test\", \"_uid\": \"h1\", \"count\": 1}"}`} {
+		var record exportRecord
+		if e := json.Unmarshal([]byte(s), &record); nil != e {
+			t.Fatal(e)
+		}
+		job, e := record.job(nil)
+		if nil != e {
+			t.Error(s, e)
+			continue
+		}
+		if "h1" != job.handler_id || "test" != job.handlerType() || !strings.Contains(job.handler, `"count": 1`) {
+			t.Error(s, "actual is", job.handler_id, job.handler)
+		}
+		if !job.run_at.IsZero() || !job.created_at.IsZero() {
+			t.Error(s, "actual is", job.run_at, job.created_at)
+		}
+	}
+
+	for _, s := range []string{`{}`, `{"handler": null}`, `{"handler": {"a": 1}}`, `{"handler": "abc"}`} {
+		var record exportRecord
+		if e := json.Unmarshal([]byte(s), &record); nil != e {
+			t.Fatal(e)
+		}
+		if _, e := record.job(nil); nil == e {
+			t.Error(s, "excepted error, actual is nil")
+		}
+	}
+
+	run_at := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	record, e := newExportRecord(map[string]interface{}{"id": int64(3),
+		"priority":     2,
+		"repeat_count": 0,
+		"attempts":     4,
+		"max_attempts": 5,
+		"queue":        "q",
+		"handler":      `{"type": "test", "password": "123"}`,
+		"handler_id":   "h2",
+		"last_error":   "login failed, password=123",
+		"run_at":       run_at,
+		"created_at":   run_at,
+		"updated_at":   run_at}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	bs, e := json.Marshal(record)
+	if nil != e {
+		t.Fatal(e)
+	}
+	var m map[string]interface{}
+	if e := json.Unmarshal(bs, &m); nil != e {
+		t.Fatal(e)
+	}
+	handler, _ := m["handler"].(map[string]interface{})
+	if 2 != m["priority"].(float64) || 4 != m["attempts"].(float64) || "h2" != m["handler_id"] ||
+		"2020-01-02T03:04:05Z" != m["run_at"] || nil == handler || "123" != handler["password"] {
+		t.Error("actual is", string(bs))
+	}
+	if strings.Contains(record.LastError, "123") {
+		t.Error("last_error isn't redacted,", record.LastError)
+	}
+	if _, ok := m["failed_at"]; ok {
+		t.Error("actual is", string(bs))
+	}
+}
+
+func TestExportAndImportJobs(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		run_at := time.Now().Add(time.Hour).Truncate(time.Second)
+		var jobs []*Job
+		for i := 0; i < 3; i++ {
+			job, e := newJob(backend, i+1, 0, "", 7, "export", run_at, map[string]interface{}{"type": "test", "content": "hello"}, false)
+			if nil != e {
+				t.Fatal(e)
+			}
+			jobs = append(jobs, job)
+		}
+		if e := backend.create(jobs...); nil != e {
+			t.Fatal(e)
+		}
+		if e := backend.update(jobs[0].id, map[string]interface{}{"@attempts": 3, "@failed_at": time.Now(), "@last_error": "connect timeout"}); nil != e {
+			t.Fatal(e)
+		}
+
+		old := *bulk_batch_size
+		*bulk_batch_size = 2
+		defer func() {
+			*bulk_batch_size = old
+		}()
+
+		front := &webFront{dbBackend: backend, base_path: "/"}
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("GET", "/export?queue=export", nil))
+		if http.StatusOK != w.Code {
+			t.Fatal(w.Code, w.Body.String())
+		}
+		if "" != w.Result().Trailer.Get("X-Export-Error") {
+			t.Fatal(w.Result().Trailer.Get("X-Export-Error"))
+		}
+		exported := w.Body.String()
+		if 3 != len(strings.Split(strings.TrimSpace(exported), "\n")) {
+			t.Fatal("actual is", exported)
+		}
+
+		for _, job := range jobs {
+			if e := backend.destroy(job.id); nil != e {
+				t.Fatal(e)
+			}
+		}
+
+		w = httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("POST", "/import", strings.NewReader(exported)))
+		if http.StatusOK != w.Code || `{"imported":3}` != strings.TrimSpace(w.Body.String()) {
+			t.Fatal(w.Code, w.Body.String())
+		}
+
+		for i, job := range jobs {
+			results, e := backend.where(map[string]interface{}{"@handler_id": job.handler_id})
+			if nil != e {
+				t.Fatal(e)
+			}
+			if 1 != len(results) {
+				t.Error("excepted 1 job, actual is", results)
+				continue
+			}
+			result := results[0]
+			if i+1 != result["priority"] || 7 != result["max_attempts"] || "export" != result["queue"] ||
+				!run_at.Equal(result["run_at"].(time.Time)) {
+				t.Error("actual is", result)
+			}
+			if 0 == i {
+				if 3 != result["attempts"] || true != result["failed"] || "connect timeout" != result["last_error"] {
+					t.Error("actual is", result)
+				}
+			} else if 0 != result["attempts"] || false != result["failed"] {
+				t.Error("actual is", result)
+			}
+		}
+
+		// 再导入一次时替换原来的任务
+		count, e := backend.importJobs(strings.NewReader(exported), 0)
+		if nil != e || 3 != count {
+			t.Error("actual is", count, e)
+		}
+		if n, _ := backend.count(map[string]interface{}{"@queue": "export"}); 3 != n {
+			t.Error("excepted 3 jobs, actual is", n)
+		}
+
+		count, e = backend.importJobs(bytes.NewBufferString(exported+"{\"handler\": {}}\n"), 0)
+		if nil == e || 2 != count || !strings.Contains(e.Error(), "line 4") {
+			t.Error("actual is", count, e)
+		}
+	})
+}
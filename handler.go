@@ -1,6 +1,8 @@
 package delayed_job
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 )
 
@@ -36,6 +38,45 @@ func newHandler(ctx, options map[string]interface{}) (Handler, error) {
 	return makeHandler(ctx, options)
 }
 
+// typedHandler 是 RegisterHandler 注册的 handler， payload 是从任务参数中解析出来的
+type typedHandler[T any] struct {
+	payload T
+	perform func(ctx context.Context, payload T) error
+}
+
+func (self *typedHandler[T]) Perform() error {
+	return self.perform(context.Background(), self.payload)
+}
+
+func (self *typedHandler[T]) PerformContext(ctx context.Context) error {
+	return self.perform(ctx, self.payload)
+}
+
+// RegisterHandler 注册一个类型化的 handler， 执行任务时参数会按 json 的规则解析到 T 中，
+// 任务参数的格式和 MakeHandler 注册的 handler 相同， 例如
+//
+//	type Greeting struct {
+//		Name string `json:"name"`
+//	}
+//
+//	delayed_job.RegisterHandler("greeting", func(ctx context.Context, g Greeting) error {
+//		fmt.Println("hello,", g.Name)
+//		return nil
+//	})
+func RegisterHandler[T any](handlerType string, perform func(ctx context.Context, payload T) error) {
+	Handlers[handlerType] = func(ctx, options map[string]interface{}) (Handler, error) {
+		bs, e := json.Marshal(options)
+		if nil != e {
+			return nil, errors.New("marshal '" + handlerType + "' payload failed, " + e.Error())
+		}
+		h := &typedHandler[T]{perform: perform}
+		if e := json.Unmarshal(bs, &h.payload); nil != e {
+			return nil, errors.New("unmarshal '" + handlerType + "' payload failed, " + e.Error())
+		}
+		return h, nil
+	}
+}
+
 var test_chan = make(chan map[string]interface{}, 100)
 
 type testHandler map[string]interface{}
@@ -54,6 +95,10 @@ func (self testHandler) UpdatePayloadObject(options map[string]interface{}) {
 	options["UpdatePayloadObject"] = "UpdatePayloadObject"
 }
 
+func (self testHandler) Preview() (map[string]interface{}, error) {
+	return self, nil
+}
+
 func newTest(ctx, options map[string]interface{}) (Handler, error) {
 	return testHandler(options), nil
 }
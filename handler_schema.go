@@ -0,0 +1,338 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// HandlerSchemas 是 handler 的参数的 JSON Schema， 按 handler 的类型注册， 没有注册的 handler 不做校验
+//
+// 参数的别名用扩展字段 x-aliases 描述， 例如 {"x-aliases": {"phone_numbers": ["phoneNumbers"]}}，
+// 校验时别名的值会当作正式名称的值， handler 不区分大小写的 enum 用 {"x-enum-ignore-case": true} 描述
+var HandlerSchemas = map[string]map[string]interface{}{}
+
+// RegisterHandlerSchema 为 handler 注册参数的 JSON Schema， schema 不正确时会 panic
+func RegisterHandlerSchema(schema string, types ...string) {
+	var m map[string]interface{}
+	if e := json.Unmarshal([]byte(schema), &m); nil != e {
+		panic(errors.New("schema of '" + strings.Join(types, ",") + "' is invalid, " + e.Error()))
+	}
+	for _, t := range types {
+		HandlerSchemas[t] = m
+	}
+}
+
+type fieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// validationError 是按字段列出的校验错误
+type validationError struct {
+	Errors []fieldError
+}
+
+func (self *validationError) Error() string {
+	ss := make([]string, len(self.Errors))
+	for i, fe := range self.Errors {
+		ss[i] = fe.Field + " " + fe.Message
+	}
+	return strings.Join(ss, "; ")
+}
+
+// withPrefix 在所有字段前增加前缀， 用于批量提交时标明是第几个任务
+func (self *validationError) withPrefix(prefix string) *validationError {
+	errs := make([]fieldError, len(self.Errors))
+	for i, fe := range self.Errors {
+		errs[i] = fieldError{Field: prefix + "." + fe.Field, Message: fe.Message}
+	}
+	return &validationError{Errors: errs}
+}
+
+func schemaAliases(schema map[string]interface{}) map[string][]string {
+	aliases := map[string][]string{}
+	m, _ := schema["x-aliases"].(map[string]interface{})
+	for name, o := range m {
+		list, _ := o.([]interface{})
+		for _, alias := range list {
+			if s, ok := alias.(string); ok {
+				aliases[name] = append(aliases[name], s)
+			}
+		}
+	}
+	return aliases
+}
+
+// validateHandler 用注册的 schema 校验 handler 的参数
+func validateHandler(options map[string]interface{}) error {
+	t := stringWithDefault(options, "type", "")
+	if "" == t {
+		return &validationError{Errors: []fieldError{{Field: "handler.type", Message: "is required"}}}
+	}
+	if _, ok := Handlers[t]; !ok {
+		return &validationError{Errors: []fieldError{{Field: "handler.type", Message: "'" + t + "' is unsupported handler"}}}
+	}
+	schema, ok := HandlerSchemas[t]
+	if !ok {
+		return nil
+	}
+
+	// 与 newHandler 一样合并 attributes， 并将别名转换为正式名称， 这里只修改副本
+	values := make(map[string]interface{}, len(options))
+	for k, v := range options {
+		values[k] = v
+	}
+	if attributes, ok := options["attributes"].(map[string]interface{}); ok {
+		for k, v := range attributes {
+			values[k] = v
+		}
+	}
+	for name, aliases := range schemaAliases(schema) {
+		if _, ok := values[name]; ok {
+			continue
+		}
+		for _, alias := range aliases {
+			if v, ok := values[alias]; ok {
+				values[name] = v
+				break
+			}
+		}
+	}
+
+	errs := validateSchema("handler", schema, values)
+	if 0 == len(errs) {
+		return nil
+	}
+	return &validationError{Errors: errs}
+}
+
+func isSchemaType(t string, value interface{}) bool {
+	switch t {
+	case "null":
+		return nil == value
+	case "string":
+		_, ok := value.(string)
+		return ok
+	case "boolean":
+		_, ok := value.(bool)
+		return ok
+	case "object":
+		_, ok := value.(map[string]interface{})
+		return ok
+	case "array":
+		switch value.(type) {
+		case []interface{}, []string:
+			return true
+		}
+		return false
+	case "integer":
+		switch v := value.(type) {
+		case int, int32, int64, uint, uint32, uint64:
+			return true
+		case float64:
+			return v == math.Trunc(v)
+		case json.Number:
+			_, e := v.Int64()
+			return nil == e
+		}
+		return false
+	case "number":
+		switch value.(type) {
+		case int, int32, int64, uint, uint32, uint64, float32, float64, json.Number:
+			return true
+		}
+		return false
+	}
+	return true
+}
+
+func schemaTypes(schema map[string]interface{}) []string {
+	switch t := schema["type"].(type) {
+	case string:
+		return []string{t}
+	case []interface{}:
+		types := make([]string, 0, len(t))
+		for _, o := range t {
+			if s, ok := o.(string); ok {
+				types = append(types, s)
+			}
+		}
+		return types
+	}
+	return nil
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case json.Number:
+		f, e := v.Float64()
+		return f, nil == e
+	case string:
+		return 0, false
+	}
+	f, e := strconv.ParseFloat(fmt.Sprint(value), 64)
+	return f, nil == e
+}
+
+// validateSchema 按 JSON Schema 的一个子集校验， 支持 type、 enum、 required、 properties、
+// items、 minLength、 minimum、 maximum 和 anyOf
+func validateSchema(field string, schema map[string]interface{}, value interface{}) []fieldError {
+	if types := schemaTypes(schema); 0 != len(types) {
+		matched := false
+		for _, t := range types {
+			if isSchemaType(t, value) {
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			return []fieldError{{Field: field, Message: "must be " + strings.Join(types, " or ")}}
+		}
+	}
+
+	if enum, ok := schema["enum"].([]interface{}); ok {
+		ignore_case, _ := schema["x-enum-ignore-case"].(bool)
+		found := false
+		for _, v := range enum {
+			if fmt.Sprint(v) == fmt.Sprint(value) ||
+				(ignore_case && strings.EqualFold(fmt.Sprint(v), fmt.Sprint(value))) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			bs, _ := json.Marshal(enum)
+			return []fieldError{{Field: field, Message: "must be one of " + string(bs)}}
+		}
+	}
+
+	var errs []fieldError
+	if s, ok := value.(string); ok {
+		if min, ok := toFloat(schema["minLength"]); ok && float64(len(s)) < min {
+			if 1 == min {
+				errs = append(errs, fieldError{Field: field, Message: "must not be empty"})
+			} else {
+				errs = append(errs, fieldError{Field: field, Message: "must be at least " + fmt.Sprint(min) + " characters"})
+			}
+		}
+	}
+	if f, ok := toFloat(value); ok {
+		if _, isString := value.(string); !isString {
+			if min, ok := toFloat(schema["minimum"]); ok && f < min {
+				errs = append(errs, fieldError{Field: field, Message: "must be greater than or equal to " + fmt.Sprint(min)})
+			}
+			if max, ok := toFloat(schema["maximum"]); ok && f > max {
+				errs = append(errs, fieldError{Field: field, Message: "must be less than or equal to " + fmt.Sprint(max)})
+			}
+		}
+	}
+
+	if m, ok := value.(map[string]interface{}); ok {
+		if required, ok := schema["required"].([]interface{}); ok {
+			for _, o := range required {
+				name, _ := o.(string)
+				if v, ok := m[name]; !ok || nil == v {
+					errs = append(errs, fieldError{Field: field + "." + name, Message: "is required"})
+				}
+			}
+		}
+		if properties, ok := schema["properties"].(map[string]interface{}); ok {
+			names := make([]string, 0, len(properties))
+			for name := range properties {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			for _, name := range names {
+				sub, _ := properties[name].(map[string]interface{})
+				if v, ok := m[name]; ok && nil != v && nil != sub {
+					errs = append(errs, validateSchema(field+"."+name, sub, v)...)
+				}
+			}
+		}
+	}
+
+	if items, ok := schema["items"].(map[string]interface{}); ok {
+		switch array := value.(type) {
+		case []interface{}:
+			for i, v := range array {
+				errs = append(errs, validateSchema(field+"["+strconv.Itoa(i)+"]", items, v)...)
+			}
+		case []string:
+			for i, v := range array {
+				errs = append(errs, validateSchema(field+"["+strconv.Itoa(i)+"]", items, v)...)
+			}
+		}
+	}
+
+	if anyOf, ok := schema["anyOf"].([]interface{}); ok {
+		var first []fieldError
+		matched := false
+		for _, o := range anyOf {
+			sub, _ := o.(map[string]interface{})
+			sub_errs := validateSchema(field, sub, value)
+			if 0 == len(sub_errs) {
+				matched = true
+				break
+			}
+			if nil == first {
+				first = sub_errs
+			}
+		}
+		if !matched {
+			if message, ok := schema["x-anyOf-message"].(string); ok {
+				errs = append(errs, fieldError{Field: field, Message: message})
+			} else {
+				errs = append(errs, first...)
+			}
+		}
+	}
+	return errs
+}
+
+type handlerDescription struct {
+	Type        string                 `json:"type"`
+	Description string                 `json:"description,omitempty"`
+	Aliases     map[string][]string    `json:"aliases,omitempty"`
+	Schema      map[string]interface{} `json:"schema,omitempty"`
+}
+
+func handlerDescriptions() []handlerDescription {
+	names := make([]string, 0, len(Handlers))
+	for name := range Handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	results := make([]handlerDescription, 0, len(names))
+	for _, name := range names {
+		desc := handlerDescription{Type: name}
+		if schema, ok := HandlerSchemas[name]; ok {
+			desc.Description, _ = schema["description"].(string)
+			desc.Schema = schema
+			if aliases := schemaAliases(schema); 0 != len(aliases) {
+				desc.Aliases = aliases
+			}
+		}
+		results = append(results, desc)
+	}
+	return results
+}
+
+func handlersHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(handlerDescriptions())
+}
+
+// writeValidationError 以 json 格式返回按字段列出的校验错误
+func writeValidationError(w http.ResponseWriter, e *validationError) {
+	writeJSON(w, http.StatusUnprocessableEntity, map[string]interface{}{"error": &apiError{Code: "validation_failed",
+		Message: e.Error(),
+		Errors:  e.Errors}})
+}
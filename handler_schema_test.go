@@ -0,0 +1,107 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func fieldsOf(e error) []string {
+	ve, ok := e.(*validationError)
+	if !ok {
+		return nil
+	}
+	var fields []string
+	for _, fe := range ve.Errors {
+		fields = append(fields, fe.Field)
+	}
+	return fields
+}
+
+func TestValidateHandler(t *testing.T) {
+	for _, test := range []struct {
+		options map[string]interface{}
+		fields  []string
+	}{{map[string]interface{}{}, []string{"handler.type"}},
+		{map[string]interface{}{"type": "abc"}, []string{"handler.type"}},
+		{map[string]interface{}{"type": "test"}, nil},
+		{map[string]interface{}{"type": "mail"}, []string{"handler.subject", "handler"}},
+		{map[string]interface{}{"type": "mail", "subject": "a", "content_text": "b"}, nil},
+		{map[string]interface{}{"type": "mail", "subject": "a", "attributes": map[string]interface{}{"content": "b"}}, nil},
+		{map[string]interface{}{"type": "mail", "subject": "", "content": "b", "content_type": "abc"}, []string{"handler.content_type", "handler.subject"}},
+		{map[string]interface{}{"type": "web", "method": "POST", "url": "http://127.0.0.1"}, nil},
+		{map[string]interface{}{"type": "web", "method": "abc", "url": "http://127.0.0.1"}, []string{"handler.method"}},
+		{map[string]interface{}{"type": "web", "method": "POST"}, []string{"handler"}},
+		// 与 handler 一样， method 和 auth_type 不区分大小写， 有 body 时可以不指定 method
+		{map[string]interface{}{"type": "web", "method": "post", "url": "http://127.0.0.1"}, nil},
+		{map[string]interface{}{"type": "web", "url": "http://127.0.0.1", "body": "abc"}, nil},
+		{map[string]interface{}{"type": "web", "url": "http://127.0.0.1", "body": json.Number("12")}, nil},
+		{map[string]interface{}{"type": "mail", "subject": "a", "content": "b", "user": "u", "auth_type": "LOGIN"}, nil},
+		{map[string]interface{}{"type": "mail", "subject": "a", "content": "b", "auth_type": "abc"}, []string{"handler.auth_type"}},
+		{map[string]interface{}{"type": "web", "websms_type": "aa", "phoneNumbers": 12}, []string{"handler.phone_numbers"}},
+		{map[string]interface{}{"type": "web", "method": "POST", "url": "a", "responseCode": json.Number("200")}, nil},
+		{map[string]interface{}{"type": "kafka", "addresses": []interface{}{"a"}, "topic": "b"}, []string{"handler.content"}}} {
+		fields := fieldsOf(validateHandler(test.options))
+		if len(test.fields) != len(fields) {
+			t.Error("excepted fields is", test.fields, ", actual is", fields, "for", test.options)
+			continue
+		}
+		for i := range fields {
+			if test.fields[i] != fields[i] {
+				t.Error("excepted fields is", test.fields, ", actual is", fields, "for", test.options)
+				break
+			}
+		}
+	}
+}
+
+func TestValidateSchema(t *testing.T) {
+	schema := map[string]interface{}{"type": "object",
+		"properties": map[string]interface{}{"count": map[string]interface{}{"type": "integer", "minimum": 1, "maximum": 3},
+			"names": map[string]interface{}{"type": "array", "items": map[string]interface{}{"type": "string"}}}}
+
+	for value, excepted := range map[string]int{`{"count": 2, "names": ["a"]}`: 0,
+		`{"count": 2.5}`:            1,
+		`{"count": 0}`:              1,
+		`{"count": 4}`:              1,
+		`{"count": "1"}`:            1,
+		`{"names": ["a", 1, true]}`: 2} {
+		decoder := json.NewDecoder(bytes.NewBufferString(value))
+		decoder.UseNumber()
+		var m map[string]interface{}
+		if e := decoder.Decode(&m); nil != e {
+			t.Error(e)
+			continue
+		}
+		if errs := validateSchema("x", schema, m); excepted != len(errs) {
+			t.Error("excepted errors is", excepted, ", actual is", errs, "for", value)
+		}
+	}
+}
+
+func TestPushWithValidationError(t *testing.T) {
+	w := httptest.NewRecorder()
+	pushAllHandler(w, httptest.NewRequest("POST", "/pushAll",
+		bytes.NewBufferString(`[{"handler": {"type": "test"}}, {"handler": {"type": "kafka", "topic": ""}}]`)), &dbBackend{})
+	if http.StatusUnprocessableEntity != w.Code {
+		t.Error("excepted status is 422, actual is", w.Code, w.Body.String())
+		return
+	}
+
+	var res struct {
+		Error apiError `json:"error"`
+	}
+	if e := json.Unmarshal(w.Body.Bytes(), &res); nil != e {
+		t.Error(e, w.Body.String())
+		return
+	}
+	if "validation_failed" != res.Error.Code || 3 != len(res.Error.Errors) {
+		t.Error("error is invalid -", w.Body.String())
+		return
+	}
+	if "data[1].handler.addresses" != res.Error.Errors[0].Field {
+		t.Error("excepted field is data[1].handler.addresses, actual is", res.Error.Errors[0].Field)
+	}
+}
@@ -0,0 +1,345 @@
+package delayed_job
+
+import (
+	"database/sql"
+	"errors"
+	"flag"
+	"strconv"
+	"sync"
+	"time"
+	"unicode/utf8"
+)
+
+var (
+	history_table = flag.String("history.db_table", "", "the table name for the attempt history, default is the job table name with suffix '_attempts'")
+	history_ttl   = flag.Duration("history.ttl", 7*24*time.Hour, "the attempt history older than it is removed, 0 is keep forever")
+
+	history_lock      sync.Mutex
+	history_purged_at time.Time
+	history_warned    bool
+)
+
+const (
+	attempt_succeeded = "succeeded"
+	attempt_retried   = "retried"
+	attempt_failed    = "failed"
+
+	max_stats_buckets = 120
+)
+
+func historyTableName() string {
+	if "" != *history_table {
+		return *history_table
+	}
+	return *table_name + "_attempts"
+}
+
+// attemptRecord 是任务的一次执行记录， 任务被删除后它仍然保留， 直到超过 history.ttl
+type attemptRecord struct {
+	Id         int64     `json:"id"`
+	JobId      int64     `json:"job_id"`
+	Attempt    int       `json:"attempt"`
+	Status     string    `json:"status"`
+	Queue      string    `json:"queue,omitempty"`
+	Type       string    `json:"type,omitempty"`
+	Worker     string    `json:"worker,omitempty"`
+	LastError  string    `json:"last_error,omitempty"`
+	DurationMs int64     `json:"duration_ms"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// historyTableScripts 返回创建执行记录表的语句， 表已存在时不会删除它
+func historyTableScripts(dbType int) []string {
+	name := historyTableName()
+	switch dbType {
+	case MSSQL:
+		return []string{`if object_id('dbo.` + name + `', 'U') is null
+				BEGIN
+				 CREATE TABLE dbo.` + name + ` (
+						  id                INT IDENTITY(1,1)  PRIMARY KEY,
+						  job_id            int NOT NULL,
+						  attempt           int NOT NULL,
+						  status            varchar(20) NOT NULL,
+						  queue             varchar(200),
+						  handler_type      varchar(100),
+						  worker            varchar(200),
+						  last_error        varchar(2000),
+						  duration_ms       int NOT NULL,
+						  created_at        DATETIME2 NOT NULL
+						);
+				END`}
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+				  id                SERIAL PRIMARY KEY,
+				  job_id            int NOT NULL,
+				  attempt           int NOT NULL,
+				  status            varchar(20) NOT NULL,
+				  queue             varchar(200),
+				  handler_type      varchar(100),
+				  worker            varchar(200),
+				  last_error        varchar(2000),
+				  duration_ms       int NOT NULL,
+				  created_at        timestamp with time zone NOT NULL
+				);`}
+	case ORACLE:
+		return []string{`BEGIN
+   EXECUTE IMMEDIATE 'CREATE SEQUENCE seq_` + name + `';
+EXCEPTION
+   WHEN OTHERS THEN
+      IF SQLCODE != -955 THEN
+         RAISE;
+      END IF;
+END;`,
+			`BEGIN
+   EXECUTE IMMEDIATE 'CREATE TABLE ` + name + ` (
+					  id                INTEGER DEFAULT seq_` + name + `.NEXTVAL PRIMARY KEY,
+					  job_id            NUMBER(10) NOT NULL,
+					  attempt           NUMBER(10) NOT NULL,
+					  status            varchar2(20 BYTE) NOT NULL,
+					  queue             varchar2(200 BYTE),
+					  handler_type      varchar2(100 BYTE),
+					  worker            varchar2(200 BYTE),
+					  last_error        varchar2(2000 BYTE),
+					  duration_ms       NUMBER(10) NOT NULL,
+					  created_at        timestamp with time zone NOT NULL
+					)';
+EXCEPTION
+   WHEN OTHERS THEN
+      IF SQLCODE != -955 THEN
+         RAISE;
+      END IF;
+END;`}
+	case DM:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  id                INT IDENTITY(1,1)  PRIMARY KEY,
+					  job_id            NUMBER(10) NOT NULL,
+					  attempt           NUMBER(10) NOT NULL,
+					  status            varchar2(20 BYTE) NOT NULL,
+					  queue             varchar2(200 BYTE),
+					  handler_type      varchar2(100 BYTE),
+					  worker            varchar2(200 BYTE),
+					  last_error        varchar2(2000 BYTE),
+					  duration_ms       NUMBER(10) NOT NULL,
+					  created_at        timestamp with time zone NOT NULL
+					)`}
+	default:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  id                SERIAL PRIMARY KEY,
+					  job_id            int NOT NULL,
+					  attempt           int NOT NULL,
+					  status            varchar(20) NOT NULL,
+					  queue             varchar(200),
+					  handler_type      varchar(100),
+					  worker            varchar(200),
+					  last_error        varchar(2000),
+					  duration_ms       int NOT NULL,
+					  created_at        DATETIME NOT NULL
+					);`}
+	}
+}
+
+func (self *dbBackend) initHistoryTable() error {
+	for _, script := range historyTableScripts(self.dbType) {
+		if _, e := self.db.Exec(script); nil != e {
+			return i18n(self.dbType, self.drv, e)
+		}
+	}
+	return nil
+}
+
+// truncateText 按字节截断字符串， 不会截断在字符的中间
+func truncateText(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	for n > 0 && !utf8.RuneStart(s[n]) {
+		n--
+	}
+	return s[:n]
+}
+
+// recordAttempt 记录任务的一次执行， 记录失败时只打印一次日志， 不影响任务本身
+func (self *dbBackend) recordAttempt(job *Job, status, worker string, elapsed time.Duration, err error) {
+	record := &attemptRecord{JobId: job.id,
+		Attempt:    job.attempts + 1,
+		Status:     status,
+		Queue:      job.queue,
+		Type:       job.handlerType(),
+		Worker:     worker,
+		DurationMs: int64(elapsed / time.Millisecond),
+		CreatedAt:  self.db_time_now()}
+	if nil != err {
+		record.LastError = truncateText(redactText(err.Error()), 2000)
+	}
+
+	e := self.insertAttempt(record)
+	history_lock.Lock()
+	defer history_lock.Unlock()
+	if nil != e {
+		if !history_warned {
+			history_warned = true
+			logger.Warn("write attempt history failed, run '-mode migrate' to create the table", "job_id", job.id, "error", e)
+		}
+		return
+	}
+
+	if *history_ttl > 0 && time.Now().Sub(history_purged_at) > time.Hour {
+		history_purged_at = time.Now()
+		if e := self.purgeAttempts(record.CreatedAt.Add(-*history_ttl)); nil != e {
+			logger.Warn("remove old attempt history failed", "error", e)
+		}
+	}
+}
+
+func (self *dbBackend) insertAttempt(record *attemptRecord) error {
+	ss := placeholders(self.dbType, 1, 9)
+	_, e := self.db.Exec("INSERT INTO "+historyTableName()+"(job_id, attempt, status, queue, handler_type, worker, last_error, duration_ms, created_at) VALUES("+
+		ss[0]+", "+ss[1]+", "+ss[2]+", "+ss[3]+", "+ss[4]+", "+ss[5]+", "+ss[6]+", "+ss[7]+", "+ss[8]+")",
+		record.JobId, record.Attempt, record.Status, record.Queue, record.Type, record.Worker, record.LastError, record.DurationMs, record.CreatedAt)
+	if nil != e {
+		return i18n(self.dbType, self.drv, e)
+	}
+	return nil
+}
+
+func (self *dbBackend) purgeAttempts(before time.Time) error {
+	_, e := self.db.Exec("DELETE FROM "+historyTableName()+" WHERE created_at < "+placeholders(self.dbType, 1, 1)[0], before)
+	if nil != e {
+		return i18n(self.dbType, self.drv, e)
+	}
+	return nil
+}
+
+// attempts 返回任务的执行记录， 按执行的先后排序
+func (self *dbBackend) attempts(job_id int64) ([]*attemptRecord, error) {
+	rows, e := self.db.Query("SELECT id, job_id, attempt, status, queue, handler_type, worker, last_error, duration_ms, created_at FROM "+
+		historyTableName()+" WHERE job_id = "+placeholders(self.dbType, 1, 1)[0]+" ORDER BY id", job_id)
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	results := []*attemptRecord{}
+	for rows.Next() {
+		var record attemptRecord
+		var queue, handler_type, worker, last_error sql.NullString
+		e = rows.Scan(&record.Id,
+			&record.JobId,
+			&record.Attempt,
+			&record.Status,
+			&queue,
+			&handler_type,
+			&worker,
+			&last_error,
+			&record.DurationMs,
+			&record.CreatedAt)
+		if nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+		record.Queue = queue.String
+		record.Type = handler_type.String
+		record.Worker = worker.String
+		record.LastError = last_error.String
+		results = append(results, &record)
+	}
+
+	if e = rows.Err(); nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	return results, nil
+}
+
+// attemptCounts 按队列和结果统计 [from, to) 之间的执行次数
+func (self *dbBackend) attemptCounts(from, to time.Time) (map[string]map[string]int64, error) {
+	ss := placeholders(self.dbType, 1, 2)
+	rows, e := self.db.Query("SELECT queue, status, COUNT(*) FROM "+historyTableName()+
+		" WHERE created_at >= "+ss[0]+" AND created_at < "+ss[1]+" GROUP BY queue, status", from, to)
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	results := map[string]map[string]int64{}
+	for rows.Next() {
+		var queue sql.NullString
+		var status string
+		var count int64
+		if e = rows.Scan(&queue, &status, &count); nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+		counts := results[queue.String]
+		if nil == counts {
+			counts = map[string]int64{}
+			results[queue.String] = counts
+		}
+		counts[status] += count
+	}
+
+	if e = rows.Err(); nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	return results, nil
+}
+
+// statsBucket 是一个时间段内的执行次数
+type statsBucket struct {
+	Time      time.Time `json:"time"`
+	Succeeded int64     `json:"succeeded"`
+	Retried   int64     `json:"retried"`
+	Failed    int64     `json:"failed"`
+}
+
+// throughput 将 [to - duration, to) 等分为 buckets 段， 统计每段的执行次数， queue 为空时统计全部队列
+func (self *dbBackend) throughput(to time.Time, duration time.Duration, buckets int, queue string) ([]statsBucket, error) {
+	if buckets <= 0 || buckets > max_stats_buckets {
+		return nil, errors.New("buckets must is between 1 and " + strconv.Itoa(max_stats_buckets) + ", actual value is " + strconv.Itoa(buckets))
+	}
+	step := duration / time.Duration(buckets)
+	if step <= 0 {
+		return nil, errors.New("range is too small")
+	}
+
+	from := to.Add(-step * time.Duration(buckets))
+	results := make([]statsBucket, buckets)
+	for i := range results {
+		results[i].Time = from.Add(step * time.Duration(i))
+	}
+
+	// 只查询一次， 在内存中分段统计
+	ss := placeholders(self.dbType, 1, 3)
+	query := "SELECT created_at, status FROM " + historyTableName() + " WHERE created_at >= " + ss[0] + " AND created_at < " + ss[1]
+	args := []interface{}{from, to}
+	if "" != queue {
+		query += " AND queue = " + ss[2]
+		args = append(args, queue)
+	}
+	rows, e := self.db.Query(query, args...)
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var created_at time.Time
+		var status string
+		if e = rows.Scan(&created_at, &status); nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+		idx := int(created_at.Sub(from) / step)
+		if idx < 0 || idx >= buckets {
+			continue
+		}
+		switch status {
+		case attempt_succeeded:
+			results[idx].Succeeded++
+		case attempt_retried:
+			results[idx].Retried++
+		case attempt_failed:
+			results[idx].Failed++
+		}
+	}
+	if e = rows.Err(); nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	return results, nil
+}
@@ -0,0 +1,81 @@
+package delayed_job
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestTruncateText(t *testing.T) {
+	for _, test := range []struct {
+		s        string
+		n        int
+		excepted string
+	}{{"abc", 5, "abc"},
+		{"abcdef", 3, "abc"},
+		{"中文", 4, "中"},
+		{"中文", 2, ""}} {
+		if actual := truncateText(test.s, test.n); test.excepted != actual {
+			t.Error("excepted is", test.excepted, ", actual is", actual, "for", test.s, test.n)
+		}
+	}
+}
+
+func TestAttemptHistory(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		job_id := time.Now().Unix()
+		if _, e := backend.db.Exec("DELETE FROM " + historyTableName()); nil != e {
+			t.Error(e)
+			return
+		}
+
+		job := &Job{id: job_id, queue: "history_test", handler_attributes: map[string]interface{}{"type": "test"}}
+		backend.recordAttempt(job, attempt_retried, "w1", 10*time.Millisecond, errors.New("throw a"))
+		job.attempts = 1
+		backend.recordAttempt(job, attempt_succeeded, "w1", 20*time.Millisecond, nil)
+
+		records, e := backend.attempts(job_id)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if 2 != len(records) {
+			t.Error("excepted attempts is 2, actual is", len(records))
+			return
+		}
+		if 1 != records[0].Attempt || attempt_retried != records[0].Status || "throw a" != records[0].LastError || "w1" != records[0].Worker {
+			t.Error("attempt 1 is invalid -", records[0])
+		}
+		if 2 != records[1].Attempt || attempt_succeeded != records[1].Status || "" != records[1].LastError || "test" != records[1].Type {
+			t.Error("attempt 2 is invalid -", records[1])
+		}
+
+		buckets, e := backend.throughput(backend.db_time_now().Add(time.Minute), time.Hour, 6, "history_test")
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if 6 != len(buckets) {
+			t.Error("excepted buckets is 6, actual is", len(buckets))
+			return
+		}
+		if 1 != buckets[5].Succeeded || 1 != buckets[5].Retried || 0 != buckets[5].Failed {
+			t.Error("last bucket is invalid -", buckets[5])
+		}
+
+		if _, e := backend.throughput(backend.db_time_now(), time.Hour, 0, ""); nil == e || !strings.Contains(e.Error(), "buckets") {
+			t.Error("excepted error of buckets, actual is", e)
+		}
+
+		if e := backend.purgeAttempts(backend.db_time_now().Add(time.Minute)); nil != e {
+			t.Error(e)
+			return
+		}
+		if records, e := backend.attempts(job_id); nil != e {
+			t.Error(e)
+		} else if 0 != len(records) {
+			t.Error("excepted attempts is purged, actual is", records)
+		}
+	})
+}
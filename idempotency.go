@@ -0,0 +1,171 @@
+package delayed_job
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"flag"
+	"time"
+)
+
+var (
+	idempotency_table = flag.String("idempotency.db_table", "", "the table name for the idempotency keys of push, default is the job table name with suffix '_idempotency'")
+	idempotency_ttl   = flag.Duration("idempotency.ttl", 24*time.Hour, "how long the idempotency keys of push are kept")
+)
+
+var (
+	errIdempotencyInProgress = errors.New("a request with the same idempotency key is in progress")
+	errIdempotencyMismatch   = errors.New("the idempotency key is already used by a different request")
+)
+
+func idempotencyTableName() string {
+	if "" != *idempotency_table {
+		return *idempotency_table
+	}
+	return *table_name + "_idempotency"
+}
+
+// idempotencyTableScripts 返回创建幂等键表的语句， 表已存在时不会删除它
+func idempotencyTableScripts(dbType int) []string {
+	name := idempotencyTableName()
+	switch dbType {
+	case MSSQL:
+		return []string{`if object_id('dbo.` + name + `', 'U') is null
+				BEGIN
+				 CREATE TABLE dbo.` + name + ` (
+						  idempotency_key   varchar(200) PRIMARY KEY,
+						  request_hash      varchar(64) NOT NULL,
+						  response          text,
+						  created_at        DATETIME2 NOT NULL
+						);
+				END`}
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+				  idempotency_key   varchar(200) PRIMARY KEY,
+				  request_hash      varchar(64) NOT NULL,
+				  response          text,
+				  created_at        timestamp with time zone NOT NULL
+				);`}
+	case ORACLE:
+		return []string{`BEGIN
+   EXECUTE IMMEDIATE 'CREATE TABLE ` + name + ` (
+					  idempotency_key   varchar2(200 BYTE) PRIMARY KEY,
+					  request_hash      varchar2(64 BYTE) NOT NULL,
+					  response          clob,
+					  created_at        timestamp with time zone NOT NULL
+					)';
+EXCEPTION
+   WHEN OTHERS THEN
+      IF SQLCODE != -955 THEN
+         RAISE;
+      END IF;
+END;`}
+	case DM:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  idempotency_key   varchar2(200 BYTE) PRIMARY KEY,
+					  request_hash      varchar2(64 BYTE) NOT NULL,
+					  response          clob,
+					  created_at        timestamp with time zone NOT NULL
+					)`}
+	default:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  idempotency_key   varchar(200) PRIMARY KEY,
+					  request_hash      varchar(64) NOT NULL,
+					  response          text,
+					  created_at        DATETIME NOT NULL
+					);`}
+	}
+}
+
+func (self *dbBackend) initIdempotencyTable() error {
+	for _, script := range idempotencyTableScripts(self.dbType) {
+		if _, e := self.db.Exec(script); nil != e {
+			return i18n(self.dbType, self.drv, e)
+		}
+	}
+	return nil
+}
+
+func requestHash(body []byte) string {
+	sum := sha256.Sum256(body)
+	return hex.EncodeToString(sum[:])
+}
+
+func (self *dbBackend) readIdempotencyKey(key string) (hash string, response sql.NullString, found bool, e error) {
+	ph := placeholders(self.dbType, 1, 1)
+	e = self.db.QueryRow("SELECT request_hash, response FROM "+idempotencyTableName()+
+		" WHERE idempotency_key = "+ph[0], key).Scan(&hash, &response)
+	if nil != e {
+		if sql.ErrNoRows == e {
+			return "", response, false, nil
+		}
+		return "", response, false, i18n(self.dbType, self.drv, e)
+	}
+	return hash, response, true, nil
+}
+
+// pushJobs 创建任务并返回它们的 id， key 不为空时相同的 key 和请求体只会创建一次，
+// 重复的请求直接返回第一次创建的结果， 此时第二个返回值为 true
+func (self *dbBackend) pushJobs(key string, body []byte, jobs []*Job) ([]createdJob, bool, error) {
+	if "" == key {
+		if 0 != len(jobs) {
+			if e := self.create(jobs...); nil != e {
+				return nil, false, e
+			}
+		}
+		return createdJobs(jobs), false, nil
+	}
+	if len(key) > 200 {
+		return nil, false, errors.New("idempotency key is too long, the maximum length is 200")
+	}
+
+	now := self.db_time_now()
+	ph := placeholders(self.dbType, 1, 3)
+	if _, e := self.db.Exec("DELETE FROM "+idempotencyTableName()+" WHERE created_at < "+ph[0], now.Add(-*idempotency_ttl)); nil != e {
+		return nil, false, i18n(self.dbType, self.drv, e)
+	}
+
+	hash := requestHash(body)
+	if _, e := self.db.Exec("INSERT INTO "+idempotencyTableName()+"(idempotency_key, request_hash, response, created_at) VALUES("+ph[0]+", "+ph[1]+", NULL, "+ph[2]+")",
+		key, hash, now); nil != e {
+		// 插入失败一般是 key 已存在
+		old_hash, response, found, err := self.readIdempotencyKey(key)
+		if nil != err {
+			return nil, false, err
+		}
+		if !found {
+			return nil, false, i18n(self.dbType, self.drv, e)
+		}
+		if old_hash != hash {
+			return nil, false, errIdempotencyMismatch
+		}
+		if !response.Valid || "" == response.String {
+			return nil, false, errIdempotencyInProgress
+		}
+
+		var results []createdJob
+		if e := json.Unmarshal([]byte(response.String), &results); nil != e {
+			return nil, false, errors.New("read response of idempotency key failed, " + e.Error())
+		}
+		return results, true, nil
+	}
+
+	if 0 != len(jobs) {
+		if e := self.create(jobs...); nil != e {
+			self.db.Exec("DELETE FROM "+idempotencyTableName()+" WHERE idempotency_key = "+ph[0], key)
+			return nil, false, e
+		}
+	}
+
+	results := createdJobs(jobs)
+	bs, e := json.Marshal(results)
+	if nil != e {
+		return nil, false, e
+	}
+	if _, e := self.db.Exec("UPDATE "+idempotencyTableName()+" SET response = "+ph[0]+" WHERE idempotency_key = "+ph[1], string(bs), key); nil != e {
+		logger.Warn("save response of idempotency key failed", "key", key, "error", i18n(self.dbType, self.drv, e))
+	}
+	return results, false, nil
+}
@@ -2,11 +2,11 @@ package delayed_job
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
-	"log"
 	"runtime"
 	"strconv"
 	"sync"
@@ -78,6 +78,11 @@ func createJobFromMap(backend *dbBackend, args map[string]interface{}) (*Job, er
 	}
 
 	is_valid_rule := boolWithDefault(args, "is_valid_rule", true)
+	if is_valid_rule {
+		if e := validateHandler(handler); nil != e {
+			return nil, e
+		}
+	}
 	return newJob(backend, priority, repeat_count, repeat_interval, max_attempts, queue, run_at, handler, is_valid_rule)
 }
 
@@ -108,6 +113,11 @@ func newJob(backend *dbBackend, priority, repeat_count int, repeat_interval stri
 		if nil != e {
 			return nil, e
 		}
+		if value, ok := args["callback"]; ok && nil != value {
+			if _, e = readCallbackSpec(value); nil != e {
+				return nil, e
+			}
+		}
 	}
 	return j, nil
 }
@@ -129,6 +139,14 @@ func (self *Job) name() string {
 	return "unknow"
 }
 
+func (self *Job) handlerType() string {
+	options, e := self.attributes()
+	if nil == e && nil != options {
+		return stringWithDefault(options, "type", "")
+	}
+	return ""
+}
+
 func (self *Job) attributes() (map[string]interface{}, error) {
 	if nil != self.handler_attributes {
 		return self.handler_attributes, nil
@@ -136,10 +154,16 @@ func (self *Job) attributes() (map[string]interface{}, error) {
 	if 0 == len(self.handler) {
 		return nil, deserializationError(errors.New("handle is empty"))
 	}
-	e := json.Unmarshal([]byte(self.handler), &self.handler_attributes)
+	var attributes map[string]interface{}
+	e := json.Unmarshal([]byte(self.handler), &attributes)
 	if nil != e {
 		return nil, deserializationError(e)
 	}
+	attributes, e = decryptAttributes(attributes)
+	if nil != e {
+		return nil, deserializationError(e)
+	}
+	self.handler_attributes = attributes
 	return self.handler_attributes, nil
 }
 
@@ -165,7 +189,24 @@ func (self *Job) payload_object() (Handler, error) {
 
 var ErrTimeout = errors.New("time out")
 
+func (self *Job) traceContext(ctx context.Context) context.Context {
+	options, e := self.attributes()
+	if nil != e {
+		return ctx
+	}
+	return extractTraceContext(ctx, options)
+}
+
 func (self *Job) invokeJob() error {
+	return self.invokeJobContext(withLogger(context.Background(), jobLogger(logger, self)))
+}
+
+func (self *Job) invokeJobContext(parent context.Context) (err error) {
+	ctx, span := tracer.Start(self.traceContext(parent), "perform "+self.handlerType(), jobSpanAttributes(self))
+	defer func() {
+		endSpan(span, err)
+	}()
+
 	job, e := self.payload_object()
 	if nil != e {
 		return e
@@ -188,7 +229,11 @@ func (self *Job) invokeJob() error {
 			}
 		}()
 
-		ch <- job.Perform()
+		if performer, ok := job.(ContextHandler); ok {
+			ch <- performer.PerformContext(ctx)
+		} else {
+			ch <- job.Perform()
+		}
 	}()
 
 	timer := time.NewTimer(self.execTimeout())
@@ -246,7 +291,7 @@ func (self *Job) execTimeout() time.Duration {
 		if nil == e {
 			return i
 		}
-		log.Println("[warn] [", self.id, self.name(), "] parse exec_timeout(", m, ") failed,", e)
+		jobLogger(logger, self).Warn("parse exec_timeout failed", "exec_timeout", m, "error", e)
 	}
 	return MaxJobTimeout
 }
@@ -265,6 +310,14 @@ func stringifiedHander(params map[string]interface{}) error {
 		return nil
 	}
 
+	if attributes, ok := handler.(map[string]interface{}); ok {
+		encrypted, e := encryptAttributes(attributes)
+		if nil != e {
+			return e
+		}
+		handler = encrypted
+	}
+
 	bs, e := json.MarshalIndent(handler, "", "  ")
 	if nil != e {
 		return e
@@ -0,0 +1,301 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const max_page_size = 1000
+
+// jobQuery 是任务列表的查询条件， 分页使用 keyset 方式， 即从上一页最后一条记录之后开始读
+type jobQuery struct {
+	ids          []int64
+	state        string
+	queue        string
+	handler_type string
+	min_priority *int
+	max_priority *int
+	created_from time.Time
+	created_to   time.Time
+	run_from     time.Time
+	run_to       time.Time
+	error_text   string
+	text         string
+
+	sort_by string
+	desc    bool
+	cursor  *jobCursor
+	limit   int
+}
+
+// jobCursor 是上一页最后一条记录的排序字段的值和 id
+type jobCursor struct {
+	Value interface{} `json:"v,omitempty"`
+	Id    int64       `json:"id"`
+}
+
+func encodeCursor(c *jobCursor) string {
+	bs, _ := json.Marshal(c)
+	return base64.RawURLEncoding.EncodeToString(bs)
+}
+
+func decodeCursor(s, sort_by string) (*jobCursor, error) {
+	bs, e := base64.RawURLEncoding.DecodeString(s)
+	if nil != e {
+		return nil, errors.New("cursor '" + s + "' is invalid")
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	var c jobCursor
+	if e = decoder.Decode(&c); nil != e {
+		return nil, errors.New("cursor '" + s + "' is invalid")
+	}
+
+	switch sort_by {
+	case "priority":
+		n, ok := c.Value.(json.Number)
+		if !ok {
+			return nil, errors.New("cursor '" + s + "' is invalid")
+		}
+		i, e := n.Int64()
+		if nil != e {
+			return nil, errors.New("cursor '" + s + "' is invalid")
+		}
+		c.Value = i
+	case "run_at", "created_at":
+		txt, _ := c.Value.(string)
+		t, e := time.Parse(time.RFC3339Nano, txt)
+		if nil != e {
+			return nil, errors.New("cursor '" + s + "' is invalid")
+		}
+		c.Value = t
+	}
+	return &c, nil
+}
+
+func parseQueryTime(s string) (time.Time, error) {
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02 15:04:05", "2006-01-02"} {
+		if t, e := time.ParseInLocation(layout, s, time.Local); nil == e {
+			return t, nil
+		}
+	}
+	return time.Time{}, errors.New("'" + s + "' is not a valid time")
+}
+
+func parseQueryInt(values url.Values, key string) (*int, error) {
+	s := values.Get(key)
+	if "" == s {
+		return nil, nil
+	}
+	i, e := strconv.Atoi(s)
+	if nil != e {
+		return nil, errors.New(key + " is not a number, actual value is '" + s + "'")
+	}
+	return &i, nil
+}
+
+// parseJobQuery 从 url 参数中读取查询条件， default_limit 为 0 时表示不分页
+func parseJobQuery(values url.Values, default_limit int) (*jobQuery, error) {
+	q := &jobQuery{state: values.Get("state"),
+		queue:        values.Get("queue"),
+		handler_type: values.Get("type"),
+		error_text:   values.Get("error"),
+		text:         values.Get("q"),
+		limit:        default_limit}
+
+	if _, e := jobStateParams(q.state); nil != e {
+		return nil, e
+	}
+
+	var e error
+	if q.min_priority, e = parseQueryInt(values, "min_priority"); nil != e {
+		return nil, e
+	}
+	if q.max_priority, e = parseQueryInt(values, "max_priority"); nil != e {
+		return nil, e
+	}
+
+	for _, field := range []struct {
+		key   string
+		value *time.Time
+	}{{"created_from", &q.created_from},
+		{"created_to", &q.created_to},
+		{"run_from", &q.run_from},
+		{"run_to", &q.run_to}} {
+		if s := values.Get(field.key); "" != s {
+			if *field.value, e = parseQueryTime(s); nil != e {
+				return nil, errors.New(field.key + " is invalid, " + e.Error())
+			}
+		}
+	}
+
+	q.sort_by = values.Get("sort")
+	if strings.HasPrefix(q.sort_by, "-") {
+		q.desc = true
+		q.sort_by = q.sort_by[1:]
+	}
+	switch q.sort_by {
+	case "":
+		q.sort_by = "id"
+	case "id", "priority", "run_at", "created_at":
+	default:
+		return nil, errors.New("sort '" + q.sort_by + "' is unsupported, it must is id, priority, run_at or created_at")
+	}
+
+	if s := values.Get("limit"); "" != s {
+		limit, e := strconv.Atoi(s)
+		if nil != e || limit <= 0 {
+			return nil, errors.New("limit must is a number and geater zero, actual value is '" + s + "'")
+		}
+		q.limit = limit
+	}
+	if q.limit > max_page_size {
+		q.limit = max_page_size
+	}
+
+	if s := values.Get("cursor"); "" != s {
+		if q.cursor, e = decodeCursor(s, q.sort_by); nil != e {
+			return nil, e
+		}
+	}
+	return q, nil
+}
+
+// build 生成查询语句， 为了判断是否还有下一页， 会多读一条记录
+func (self *jobQuery) build(dbType int) (string, []interface{}) {
+	var conditions []string
+	var arguments []interface{}
+	placeholder := func(v interface{}) string {
+		arguments = append(arguments, v)
+		return placeholders(dbType, len(arguments), 1)[0]
+	}
+
+	if 0 != len(self.ids) {
+		ss := make([]string, len(self.ids))
+		for i, id := range self.ids {
+			ss[i] = placeholder(id)
+		}
+		conditions = append(conditions, "id IN ("+strings.Join(ss, ", ")+")")
+	}
+
+	switch self.state {
+	case "failed":
+		conditions = append(conditions, "failed_at IS NOT NULL")
+	case "queued":
+		conditions = append(conditions, "failed_at IS NULL", "locked_by IS NULL")
+	case "active":
+		conditions = append(conditions, "failed_at IS NULL", "locked_by IS NOT NULL")
+	}
+	if "" != self.queue {
+		conditions = append(conditions, "queue = "+placeholder(self.queue))
+	}
+	if "" != self.handler_type {
+		// handler 是缩进格式的 json， 见 newJob
+		conditions = append(conditions, "handler LIKE "+placeholder(`%"type": "`+escapeLike(dbType, self.handler_type)+`"%`)+likeEscape(dbType))
+	}
+	if nil != self.min_priority {
+		conditions = append(conditions, "priority >= "+placeholder(*self.min_priority))
+	}
+	if nil != self.max_priority {
+		conditions = append(conditions, "priority <= "+placeholder(*self.max_priority))
+	}
+	if !self.created_from.IsZero() {
+		conditions = append(conditions, "created_at >= "+placeholder(self.created_from))
+	}
+	if !self.created_to.IsZero() {
+		conditions = append(conditions, "created_at < "+placeholder(self.created_to))
+	}
+	if !self.run_from.IsZero() {
+		conditions = append(conditions, "run_at >= "+placeholder(self.run_from))
+	}
+	if !self.run_to.IsZero() {
+		conditions = append(conditions, "run_at < "+placeholder(self.run_to))
+	}
+	if "" != self.error_text {
+		conditions = append(conditions, "last_error LIKE "+placeholder("%"+escapeLike(dbType, self.error_text)+"%")+likeEscape(dbType))
+	}
+	if "" != self.text {
+		// 全文搜索 handler 和 last_error， 加密保存的 handler 只能搜到未加密的部分
+		pattern := "%" + escapeLike(dbType, self.text) + "%"
+		conditions = append(conditions, "(handler LIKE "+placeholder(pattern)+likeEscape(dbType)+
+			" OR last_error LIKE "+placeholder(pattern)+likeEscape(dbType)+")")
+	}
+
+	op := ">"
+	direction := ""
+	if self.desc {
+		op = "<"
+		direction = " DESC"
+	}
+	if nil != self.cursor {
+		if "id" == self.sort_by {
+			conditions = append(conditions, "id "+op+" "+placeholder(self.cursor.Id))
+		} else {
+			conditions = append(conditions, "("+self.sort_by+" "+op+" "+placeholder(self.cursor.Value)+
+				" OR ("+self.sort_by+" = "+placeholder(self.cursor.Value)+" AND id "+op+" "+placeholder(self.cursor.Id)+"))")
+		}
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("SELECT ")
+	if (MSSQL == dbType || SYBASE == dbType) && self.limit > 0 {
+		buffer.WriteString("TOP ")
+		buffer.WriteString(strconv.Itoa(self.limit + 1))
+	}
+	buffer.WriteString(fields_sql_string)
+	buffer.WriteString(" FROM ")
+	buffer.WriteString(*table_name)
+	if 0 != len(conditions) {
+		buffer.WriteString(" WHERE ")
+		buffer.WriteString(strings.Join(conditions, " AND "))
+	}
+	buffer.WriteString(" ORDER BY ")
+	if "id" != self.sort_by {
+		buffer.WriteString(self.sort_by)
+		buffer.WriteString(direction)
+		buffer.WriteString(", ")
+	}
+	buffer.WriteString("id")
+	buffer.WriteString(direction)
+
+	if self.limit > 0 {
+		switch dbType {
+		case MSSQL, SYBASE:
+		case ORACLE:
+			return "SELECT * FROM (" + buffer.String() + ") WHERE ROWNUM <= " + strconv.Itoa(self.limit+1), arguments
+		default:
+			buffer.WriteString(" LIMIT ")
+			buffer.WriteString(strconv.Itoa(self.limit + 1))
+		}
+	}
+	return buffer.String(), arguments
+}
+
+// findJobs 按条件查询任务， 还有下一页时返回下一页的 cursor
+func (self *dbBackend) findJobs(q *jobQuery) ([]map[string]interface{}, string, error) {
+	query, arguments := q.build(self.dbType)
+	results, e := self.queryJobs(query, arguments...)
+	if nil != e {
+		return nil, "", e
+	}
+	if q.limit <= 0 || len(results) <= q.limit {
+		return results, "", nil
+	}
+
+	results = results[:q.limit]
+	last := results[len(results)-1]
+	c := &jobCursor{Id: last["id"].(int64)}
+	switch v := last[q.sort_by].(type) {
+	case time.Time:
+		c.Value = v.Format(time.RFC3339Nano)
+	case int:
+		c.Value = v
+	}
+	return results, encodeCursor(c), nil
+}
@@ -0,0 +1,185 @@
+package delayed_job
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseJobQuery(t *testing.T) {
+	q, e := parseJobQuery(url.Values{"state": {"failed"},
+		"queue":        {"aa"},
+		"type":         {"mail"},
+		"min_priority": {"1"},
+		"created_from": {"2020-01-02"},
+		"sort":         {"-run_at"},
+		"limit":        {"5000"}}, 100)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if "failed" != q.state || "aa" != q.queue || "mail" != q.handler_type {
+		t.Error("query is invalid -", q)
+	}
+	if nil == q.min_priority || 1 != *q.min_priority || nil != q.max_priority {
+		t.Error("priority is invalid -", q.min_priority, q.max_priority)
+	}
+	if 2020 != q.created_from.Year() || !q.created_to.IsZero() {
+		t.Error("created_from is invalid -", q.created_from)
+	}
+	if "run_at" != q.sort_by || !q.desc {
+		t.Error("sort is invalid -", q.sort_by, q.desc)
+	}
+	if max_page_size != q.limit {
+		t.Error("excepted limit is", max_page_size, ", actual is", q.limit)
+	}
+
+	for _, values := range []url.Values{{"state": {"abc"}},
+		{"sort": {"handler"}},
+		{"limit": {"0"}},
+		{"min_priority": {"a"}},
+		{"run_to": {"abc"}},
+		{"cursor": {"abc"}}} {
+		if _, e := parseJobQuery(values, 0); nil == e {
+			t.Error("excepted error for", values)
+		}
+	}
+}
+
+func TestJobCursor(t *testing.T) {
+	now := time.Now()
+	for _, test := range []struct {
+		sort_by string
+		value   interface{}
+	}{{"run_at", now.Format(time.RFC3339Nano)},
+		{"priority", 12},
+		{"id", nil}} {
+		c, e := decodeCursor(encodeCursor(&jobCursor{Value: test.value, Id: 34}), test.sort_by)
+		if nil != e {
+			t.Error(e)
+			continue
+		}
+		if 34 != c.Id {
+			t.Error("excepted id is 34, actual is", c.Id)
+		}
+		switch test.sort_by {
+		case "run_at":
+			if tm, ok := c.Value.(time.Time); !ok || !tm.Equal(now) {
+				t.Error("excepted value is", now, ", actual is", c.Value)
+			}
+		case "priority":
+			if int64(12) != c.Value {
+				t.Error("excepted value is 12, actual is", c.Value)
+			}
+		}
+	}
+}
+
+func TestJobQueryBuild(t *testing.T) {
+	min_priority := 2
+	q := &jobQuery{state: "queued",
+		queue:        "aa",
+		min_priority: &min_priority,
+		sort_by:      "priority",
+		desc:         true,
+		cursor:       &jobCursor{Value: int64(3), Id: 10},
+		limit:        20}
+
+	for _, test := range []struct {
+		dbType   int
+		contains []string
+	}{{POSTGRESQL, []string{"WHERE failed_at IS NULL AND locked_by IS NULL AND queue = $1 AND priority >= $2 AND (priority < $3 OR (priority = $4 AND id < $5))",
+		"ORDER BY priority DESC, id DESC LIMIT 21"}},
+		{MYSQL, []string{"queue = ? AND priority >= ?", "ORDER BY priority DESC, id DESC LIMIT 21"}},
+		{MSSQL, []string{"SELECT TOP 21 id,", "ORDER BY priority DESC, id DESC"}},
+		{SYBASE, []string{"SELECT TOP 21 id,", "ORDER BY priority DESC, id DESC"}},
+		{ORACLE, []string{"SELECT * FROM (SELECT ", "queue = :1", "ORDER BY priority DESC, id DESC) WHERE ROWNUM <= 21"}}} {
+		query, arguments := q.build(test.dbType)
+		for _, s := range test.contains {
+			if !strings.Contains(query, s) {
+				t.Error(test.dbType, ": excepted", s, "in", query)
+			}
+		}
+		if 5 != len(arguments) {
+			t.Error(test.dbType, ": excepted 5 arguments, actual is", arguments)
+		}
+		if (MSSQL == test.dbType || SYBASE == test.dbType) && strings.Contains(query, "LIMIT") {
+			t.Error("LIMIT is unsupported by mssql -", query)
+		}
+	}
+
+	q = &jobQuery{sort_by: "id"}
+	query, arguments := q.build(MSSQL)
+	if strings.Contains(query, "TOP") || strings.Contains(query, "WHERE") || 0 != len(arguments) {
+		t.Error("query without limit is invalid -", query)
+	}
+}
+
+func TestJobQuerySearch(t *testing.T) {
+	q, e := parseJobQuery(url.Values{"q": {"timeout"}, "queue": {"aa"}}, 0)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	query, arguments := q.build(POSTGRESQL)
+	if !strings.Contains(query, `queue = $1 AND (handler LIKE $2 ESCAPE '\' OR last_error LIKE $3 ESCAPE '\')`) {
+		t.Error("query is invalid -", query)
+	}
+	if 3 != len(arguments) || "%timeout%" != arguments[1] || "%timeout%" != arguments[2] {
+		t.Error("arguments is invalid -", arguments)
+	}
+
+	// 搜索的内容中的通配符按原样匹配
+	q, e = parseJobQuery(url.Values{"q": {`50%_[a]\`}, "error": {"a_b"}}, 0)
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	for _, test := range []struct {
+		dbType   int
+		escape   string
+		excepted string
+	}{{POSTGRESQL, ` ESCAPE '\'`, `%50\%\_[a]\\%`},
+		{MYSQL, ` ESCAPE '\\'`, `%50\%\_[a]\\%`},
+		{MSSQL, ` ESCAPE '\'`, `%50\%\_\[a\]\\%`},
+		{ORACLE, ` ESCAPE '\'`, `%50\%\_[a]\\%`}} {
+		query, arguments := q.build(test.dbType)
+		if 3 != strings.Count(query, "LIKE") || 3 != strings.Count(query, test.escape) {
+			t.Error(test.dbType, ": query is invalid -", query)
+		}
+		if 3 != len(arguments) || `%a\_b%` != arguments[0] || test.excepted != arguments[1] || test.excepted != arguments[2] {
+			t.Error(test.dbType, ": arguments is invalid -", arguments)
+		}
+	}
+}
+
+func TestPagingSQL(t *testing.T) {
+	for _, test := range []struct {
+		dbType   int
+		params   map[string]interface{}
+		excepted string
+	}{
+		{MSSQL, map[string]interface{}{"limit": 10, "offset": 20},
+			"SELECT id FROM " + *table_name + " ORDER BY id OFFSET 20 ROWS FETCH NEXT 10 ROWS ONLY"},
+		{POSTGRESQL, map[string]interface{}{"limit": 10},
+			"SELECT id FROM " + *table_name + " LIMIT 10 OFFSET 0"},
+		{ORACLE, map[string]interface{}{"limit": 10},
+			"SELECT * FROM (SELECT id FROM " + *table_name + " ORDER BY id) WHERE ROWNUM <= 10"},
+		{ORACLE, map[string]interface{}{"limit": 10, "offset": 20},
+			"SELECT id FROM (SELECT paging_t.*, ROWNUM AS paging_rn FROM (SELECT id FROM " + *table_name +
+				" ORDER BY id) paging_t WHERE ROWNUM <= 30) WHERE paging_rn > 20"},
+		{SYBASE, map[string]interface{}{"limit": 10, "offset": 20},
+			"SELECT TOP 30 id FROM " + *table_name + " ORDER BY id"},
+	} {
+		query, _, e := buildSQL(test.dbType, "id", test.params)
+		if nil != e {
+			t.Error(e)
+			continue
+		}
+		if test.excepted != query {
+			t.Error("[", test.dbType, "] excepted is", test.excepted)
+			t.Error("[", test.dbType, "] actual is  ", query)
+		}
+	}
+}
@@ -3,11 +3,11 @@ package delayed_job
 import (
 	"context"
 	"errors"
-	"fmt"
 	"net"
 	"time"
 
 	kafka "github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type kafkaHandler struct {
@@ -59,6 +59,13 @@ func newKafkaHandler(ctx, params map[string]interface{}) (Handler, error) {
 }
 
 func (self *kafkaHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *kafkaHandler) PerformContext(parent context.Context) (e error) {
+	parent, span := tracer.Start(parent, "kafka "+self.topic, trace.WithSpanKind(trace.SpanKindProducer))
+	defer func() { endSpan(span, e) }()
+
 	if IsDevEnv {
 		return ErrDevEnv
 	}
@@ -70,9 +77,14 @@ func (self *kafkaHandler) Perform() error {
 		Balancer:               &kafka.LeastBytes{},
 		AllowAutoTopicCreation: true,
 	}
+	var headers []kafka.Header
+	for k, v := range traceHeaders(parent) {
+		headers = append(headers, kafka.Header{Key: k, Value: []byte(v)})
+	}
 	messages := []kafka.Message{
 		{
-			Value: []byte(self.message),
+			Value:   []byte(self.message),
+			Headers: headers,
 		},
 	}
 
@@ -83,7 +95,7 @@ func (self *kafkaHandler) Perform() error {
 			return errors.New("failed to write messages:" + err.Error())
 		}
 
-		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		ctx, cancel := context.WithTimeout(parent, 10*time.Second)
 		defer cancel()
 
 		// attempt to create topic prior to publishing the message
@@ -110,12 +122,31 @@ func (self *kafkaHandler) send(to *net.UDPAddr) error {
 	}
 	defer c.Close()
 
-	fmt.Println(c.RemoteAddr(), self.message)
+	logger.Info("send kafka", "to", c.RemoteAddr().String(), "message", self.message)
 	_, e = c.Write([]byte(self.message))
 	return e
 }
 
+func (self *kafkaHandler) Preview() (map[string]interface{}, error) {
+	return map[string]interface{}{"addresses": self.addresses,
+		"topic":   self.topic,
+		"message": self.message}, nil
+}
+
+const kafka_schema = `{
+  "description": "write a message to the kafka topic",
+  "type": "object",
+  "required": ["addresses", "topic", "content"],
+  "properties": {
+    "addresses": {"type": ["string", "array"], "description": "the brokers separated by ','"},
+    "topic": {"type": "string", "minLength": 1},
+    "content": {"type": "string", "minLength": 1},
+    "arguments": {"description": "the values of the templates"}
+  }
+}`
+
 func init() {
 	Handlers["kafka"] = newKafkaHandler
 	Handlers["kafka_command"] = newKafkaHandler
+	RegisterHandlerSchema(kafka_schema, "kafka", "kafka_command")
 }
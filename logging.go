@@ -0,0 +1,133 @@
+package delayed_job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+)
+
+var (
+	log_level  = flag.String("log.level", "info", "the level of log, 'debug', 'info', 'warn' or 'error'")
+	log_format = flag.String("log.format", "text", "the format of log, 'text' or 'json'")
+	log_file   = flag.String("log.file", "", "the file name of log, log is written to stderr if it is empty")
+
+	logger = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{ReplaceAttr: redactAttr}))
+	// 调用过 SetLogger 后， initLogger 不再覆盖它
+	logger_is_set = false
+)
+
+type loggerKey struct{}
+
+// SetLogger 设置全局的日志对象， 它替代了原来的 SetMailLogger, SetSMSLogger 和 SetTestLogger
+func SetLogger(l *slog.Logger) {
+	if nil == l {
+		l = slog.New(slog.NewTextHandler(os.Stderr, &slog.HandlerOptions{ReplaceAttr: redactAttr}))
+	}
+	logger = l
+	logger_is_set = true
+}
+
+func parseLogLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if e := level.UnmarshalText([]byte(s)); nil != e {
+		return level, errors.New("log level '" + s + "' is unsupported")
+	}
+	return level, nil
+}
+
+func newLogger(out io.Writer, format, level string) (*slog.Logger, error) {
+	lvl, e := parseLogLevel(level)
+	if nil != e {
+		return nil, e
+	}
+
+	opts := &slog.HandlerOptions{Level: lvl, ReplaceAttr: redactAttr}
+	switch strings.ToLower(format) {
+	case "", "text":
+		return slog.New(slog.NewTextHandler(out, opts)), nil
+	case "json":
+		return slog.New(slog.NewJSONHandler(out, opts)), nil
+	default:
+		return nil, errors.New("log format '" + format + "' is unsupported")
+	}
+}
+
+func initLogger() (func(), error) {
+	if logger_is_set {
+		return func() {}, nil
+	}
+
+	var out io.Writer = os.Stderr
+	closer := func() {}
+	if "" != *log_file {
+		f, e := os.OpenFile(*log_file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0666)
+		if nil != e {
+			return nil, errors.New("open log file failed, " + e.Error())
+		}
+		out = f
+		closer = func() { f.Close() }
+	}
+
+	l, e := newLogger(out, *log_format, *log_level)
+	if nil != e {
+		closer()
+		return nil, e
+	}
+	logger = l
+	return closer, nil
+}
+
+// redactAttr 屏蔽日志中的密码等字段
+func redactAttr(groups []string, a slog.Attr) slog.Attr {
+	if isSecretField(a.Key) {
+		if s := a.Value.String(); "" != s {
+			return slog.String(a.Key, redacted_text)
+		}
+		return a
+	}
+
+	switch a.Value.Kind() {
+	case slog.KindString:
+		return slog.String(a.Key, redactText(a.Value.String()))
+	case slog.KindAny:
+		switch v := a.Value.Any().(type) {
+		case map[string]interface{}:
+			return slog.Any(a.Key, redactMap(v))
+		case error:
+			return slog.String(a.Key, redactText(v.Error()))
+		}
+	}
+	return a
+}
+
+// withLogger 将日志对象保存到 ctx 中， 实现了 ContextHandler 的 Handler 可以用 loggerFrom 取出它
+func withLogger(ctx context.Context, l *slog.Logger) context.Context {
+	return context.WithValue(ctx, loggerKey{}, l)
+}
+
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if nil != ctx {
+		if l, ok := ctx.Value(loggerKey{}).(*slog.Logger); ok {
+			return l
+		}
+	}
+	return logger
+}
+
+// jobLogger 返回带有任务字段的日志对象
+func jobLogger(l *slog.Logger, job *Job) *slog.Logger {
+	l = l.With(slog.Int64("job_id", job.id),
+		slog.String("type", job.handlerType()),
+		slog.String("queue", job.queue),
+		slog.Int("attempt", job.attempts))
+	if dump_job {
+		txt, _ := json.Marshal(redactMap(job.handler_attributes))
+		l = l.With(slog.String("handler", string(txt)))
+	}
+	return l
+}
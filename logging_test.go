@@ -0,0 +1,74 @@
+package delayed_job
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+)
+
+func TestJobLogger(t *testing.T) {
+	var buffer bytes.Buffer
+	l, e := newLogger(&buffer, "json", "info")
+	if nil != e {
+		t.Error(e)
+		return
+	}
+
+	job := &Job{id: 12, queue: "aa", attempts: 2,
+		handler_attributes: map[string]interface{}{"type": "test"}}
+	jobLogger(l, job).Info("job running", "worker", "w1")
+	jobLogger(l, job).Debug("it is ignored")
+
+	var fields map[string]interface{}
+	if e := json.Unmarshal(buffer.Bytes(), &fields); nil != e {
+		t.Error(e, buffer.String())
+		return
+	}
+
+	for k, v := range map[string]interface{}{"msg": "job running",
+		"level":   "INFO",
+		"job_id":  float64(12),
+		"type":    "test",
+		"queue":   "aa",
+		"attempt": float64(2),
+		"worker":  "w1"} {
+		if fields[k] != v {
+			t.Error("excepted", k, "is", v, ", actual is", fields[k])
+		}
+	}
+}
+
+func TestNewLoggerWithInvalidArguments(t *testing.T) {
+	var buffer bytes.Buffer
+	if _, e := newLogger(&buffer, "xml", "info"); nil == e {
+		t.Error("excepted error for the invalid format")
+	}
+	if _, e := newLogger(&buffer, "text", "abc"); nil == e {
+		t.Error("excepted error for the invalid level")
+	}
+}
+
+func TestLoggerFromContext(t *testing.T) {
+	if loggerFrom(context.Background()) != logger {
+		t.Error("excepted default logger")
+	}
+
+	var buffer bytes.Buffer
+	l, _ := newLogger(&buffer, "text", "debug")
+	if loggerFrom(withLogger(context.Background(), l)) != l {
+		t.Error("excepted logger in the context")
+	}
+}
+
+func TestLoggerRedactSecrets(t *testing.T) {
+	var buffer bytes.Buffer
+	l, _ := newLogger(&buffer, "text", "info")
+	l.Info("web request", "password", "abc", "url", "http://u:p@h/", "headers", map[string]interface{}{"token": "t"})
+
+	for _, s := range []string{"abc", "u:p@", "token:t"} {
+		if bytes.Contains(buffer.Bytes(), []byte(s)) {
+			t.Error("secret", s, "isn't masked -", buffer.String())
+		}
+	}
+}
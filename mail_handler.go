@@ -3,11 +3,12 @@ package delayed_job
 import (
 	"bufio"
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
 	"io"
-	"log"
+	"log/slog"
 	"net/mail"
 	"os"
 	"os/exec"
@@ -16,12 +17,12 @@ import (
 	"time"
 
 	"github.com/runner-mei/delayed_job/smtp"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/text/encoding/simplifiedchinese"
 	"golang.org/x/text/transform"
 )
 
 var (
-	mailLogger                    *log.Logger
 	tryNTLM                       = os.Getenv("try_ntlm") == "true"
 	BlatExecute                   = os.Getenv("blat_path")
 	mailServerCharset             = flag.String("mail.auth.server_charset", "", "")
@@ -51,12 +52,8 @@ var (
 	}
 )
 
-func SetMailLogger(logger *log.Logger) {
-	mailLogger = logger
-}
-
 func useTls() smtp.TLSMethod {
-	return smtp.UseTLS(*default_mail_useTLS)
+	return smtp.UseTLS(configString("mail.useTLS"))
 }
 
 type mailHandler struct {
@@ -70,6 +67,16 @@ type mailHandler struct {
 	host        string
 	removeFiles []string
 	closers     []io.Closer
+
+	// attachments 在发送时才打开， 预览时不读取文件
+	attachments []mailAttachment
+}
+
+const attachment_placeholder = "(the content of the attachment isn't previewed)"
+
+type mailAttachment struct {
+	name string
+	file string
 }
 
 func toAddressListString(addresses []*mail.Address) string {
@@ -197,11 +204,11 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 	var host string
 	var user string = stringWithDefault(params, "user", "")
 	if 0 == len(user) {
-		authType = *default_mail_auth_type
-		user = *default_mail_auth_user
-		identity = *default_mail_auth_identity
-		password = Decrypt(*default_mail_auth_password)
-		host = *default_mail_auth_host
+		authType = configString("mail.auth.type")
+		user = configString("mail.auth.user")
+		identity = configString("mail.auth.identity")
+		password = Decrypt(configString("mail.auth.password"))
+		host = configString("mail.auth.host")
 	} else {
 		authType = stringWithDefault(params, "auth_type", "plain")
 		if 0 == len(authType) {
@@ -214,7 +221,7 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 
 	smtpServer := stringWithDefault(params, "smtp_server", "")
 	if 0 == len(smtpServer) {
-		smtpServer = *defaultSmtpServer
+		smtpServer = configString("mail.smtp_server")
 	}
 
 	if 0 == len(host) {
@@ -281,7 +288,7 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 		return nil, e
 	}
 	if nil == from {
-		from, e = toAddress(*default_mail_address, "from_address")
+		from, e = toAddress(configString("mail.from"), "from_address")
 		if nil != e {
 			return nil, e
 		}
@@ -302,7 +309,7 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 			}
 
 			if GetUserMail == nil {
-				log.Println("GetUserMail hook is empty")
+				logger.Warn("GetUserMail hook is empty")
 				continue
 			}
 			mailAddr, err := GetUserMail(id)
@@ -310,9 +317,9 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 				return nil, err
 			}
 			if mailAddr == "" {
-				log.Println("mail is missing for user", id)
+				logger.Warn("mail is missing for user", "user", id)
 			} else if addr, err := mail.ParseAddress(mailAddr); err != nil {
-				log.Println("mail '", mailAddr, "' is invalid for user", id)
+				logger.Warn("mail is invalid for user", "user", id, "mail", mailAddr)
 			} else {
 				users = append(users, addr)
 				// log.Println("mail is '", mailAddr, "' for user", id)
@@ -352,8 +359,7 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 		}
 	}
 	var removeFiles []string
-	var closers []io.Closer
-	var attachments []Attachment
+	var attachments []mailAttachment
 	if args, ok := params["attachments"]; ok {
 		if ar, ok := args.([]interface{}); ok {
 			for _, a := range ar {
@@ -385,14 +391,7 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 					removeFiles = append(removeFiles, file)
 				}
 
-				f, e := os.Open(file)
-				if nil != e {
-					return nil, e
-				}
-
-				attachments = append(attachments, Attachment{Name: nm, Content: f})
-
-				closers = append(closers, f)
+				attachments = append(attachments, mailAttachment{name: nm, file: file})
 			}
 		}
 	}
@@ -404,18 +403,48 @@ func newMailHandler(ctx, params map[string]interface{}) (Handler, error) {
 		password:    password,
 		host:        host,
 		removeFiles: removeFiles,
-		closers:     closers,
+		attachments: attachments,
 		message: &MailMessage{From: *from,
 			To:          to,
 			Cc:          cc,
 			Bcc:         bcc,
 			Subject:     subject,
 			ContentText: contentText,
-			ContentHtml: contentHtml,
-			Attachments: attachments}}, nil
+			ContentHtml: contentHtml}}, nil
+}
+
+// openAttachments 打开附件的文件， 它们在 perform 结束时关闭
+func (self *mailHandler) openAttachments() error {
+	self.message.Attachments = nil
+	for _, attachment := range self.attachments {
+		f, e := os.Open(attachment.file)
+		if nil != e {
+			for _, closer := range self.closers {
+				closer.Close()
+			}
+			self.closers = nil
+			return e
+		}
+		self.closers = append(self.closers, f)
+		self.message.Attachments = append(self.message.Attachments, Attachment{Name: attachment.name, Content: f})
+	}
+	return nil
 }
 
 func (self *mailHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *mailHandler) PerformContext(ctx context.Context) (e error) {
+	ctx, span := tracer.Start(ctx, "mail", trace.WithSpanKind(trace.SpanKindClient))
+	defer func() { endSpan(span, e) }()
+
+	// 通过邮件头将跟踪信息传给下游
+	self.message.Headers = traceHeaders(ctx)
+	return self.perform(loggerFrom(ctx).With(slog.String("handler", "mail")))
+}
+
+func (self *mailHandler) perform(l *slog.Logger) error {
 	if IsDevEnv {
 		return ErrDevEnv
 	}
@@ -424,6 +453,11 @@ func (self *mailHandler) Perform() error {
 		return nil
 	}
 
+	// 打开失败时不删除文件， 重试时还要使用它们
+	if e := self.openAttachments(); nil != e {
+		return e
+	}
+
 	close := func() {
 		if len(self.closers) > 0 {
 			for _, closer := range self.closers {
@@ -434,7 +468,7 @@ func (self *mailHandler) Perform() error {
 
 		for _, nm := range self.removeFiles {
 			if e := os.Remove(nm); nil != e {
-				log.Println("[warn] [mail] remove file - '"+nm+"',", e)
+				l.Warn("remove file failed", "file", nm, "error", e)
 			}
 		}
 	}
@@ -483,22 +517,16 @@ func (self *mailHandler) Perform() error {
 		timer.Stop()
 
 		if nil != e {
-			fmt.Println(cmd.Path, cmd.Args)
+			l.Error("execute blat failed", "path", cmd.Path, "args", cmd.Args)
 			return errors.New(string(output))
 		}
 
-		log.Println("################\r\n" + string(output) + "\r\n################")
+		l.Info("execute blat", "output", string(output))
 		// if !bytes.Contains(output, []byte(excepted)) {
 		// 	return errors.New(string(output))
 		// }
 
-		if mailLogger != nil {
-			if self.message.ContentText == "" {
-				mailLogger.Println("[mail]", self.message.To, self.message.ContentHtml)
-			} else {
-				mailLogger.Println("[mail]", self.message.To, self.message.ContentText)
-			}
-		}
+		self.logSent(l)
 		return nil
 	}
 
@@ -539,9 +567,9 @@ func (self *mailHandler) Perform() error {
 			return errors.New("unsupported auth type - " + self.authType)
 		}
 	}
-	if e := self.message.Send(self.smtpServer, auth, useTls(), *default_mail_useFQDN); nil != e {
-		if *mailServerCharset != "" {
-			switch strings.ToLower(*mailServerCharset) {
+	if e := self.message.Send(self.smtpServer, auth, useTls(), configBool("mail.useFQDN")); nil != e {
+		if charset := configString("mail.auth.server_charset"); charset != "" {
+			switch strings.ToLower(charset) {
 			case "hz2312":
 				coding := simplifiedchinese.HZGB2312
 				a, _, err := transform.Bytes(coding.NewDecoder(), []byte(e.Error()))
@@ -565,19 +593,81 @@ func (self *mailHandler) Perform() error {
 		return e
 	}
 
-	if mailLogger != nil {
-		if self.message.ContentText == "" {
-			mailLogger.Println("[mail]", self.message.To, self.message.ContentHtml)
-		} else {
-			mailLogger.Println("[mail]", self.message.To, self.message.ContentText)
-		}
-	}
+	self.logSent(l)
 	return nil
 }
 
+func (self *mailHandler) logSent(l *slog.Logger) {
+	content := self.message.ContentText
+	if content == "" {
+		content = self.message.ContentHtml
+	}
+	l.Info("mail sent", "to", toAddressListString(self.message.To), "subject", self.message.Subject, "content", content)
+}
+
+// Preview 返回渲染后的邮件， 不读取附件的文件， mime 中的附件内容是占位符
+func (self *mailHandler) Preview() (map[string]interface{}, error) {
+	attachments := make([]string, 0, len(self.attachments))
+	message := *self.message
+	message.Attachments = nil
+	for _, attachment := range self.attachments {
+		attachments = append(attachments, attachment.name)
+		message.Attachments = append(message.Attachments, Attachment{Name: attachment.name,
+			Content: strings.NewReader(attachment_placeholder)})
+	}
+
+	mime, e := message.Bytes()
+	if nil != e {
+		return nil, e
+	}
+	return map[string]interface{}{"smtp_server": self.smtpServer,
+		"from":         toMailString(&self.message.From),
+		"to":           toAddressListString(self.message.To),
+		"cc":           toAddressListString(self.message.Cc),
+		"bcc":          toAddressListString(self.message.Bcc),
+		"subject":      self.message.Subject,
+		"content_text": self.message.ContentText,
+		"content_html": self.message.ContentHtml,
+		"attachments":  attachments,
+		"mime":         string(mime)}, nil
+}
+
+const mail_schema = `{
+  "description": "send a mail by smtp",
+  "type": "object",
+  "required": ["subject"],
+  "properties": {
+    "smtp_server": {"type": "string", "description": "host:port of the smtp server, default is mail.smtp_server"},
+    "from_address": {"type": "string"},
+    "to_address": {"type": ["string", "array"], "description": "the addresses separated by ','"},
+    "cc_address": {"type": ["string", "array"]},
+    "bcc_address": {"type": ["string", "array"]},
+    "to_mail_addresses": {"type": ["string", "array"]},
+    "users": {"type": ["string", "array"], "description": "the ids of users, their mail addresses are added to to_address"},
+    "subject": {"type": "string", "minLength": 1},
+    "content": {"type": "string"},
+    "content_type": {"type": "string", "enum": ["", "text", "html"]},
+    "content_text": {"type": "string"},
+    "content_html": {"type": "string"},
+    "user": {"type": "string"},
+    "password": {"type": "string"},
+    "auth_type": {"type": "string", "enum": ["", "login", "plain", "cram-md5", "ntlm", "ntlmv1", "ntlmv2"], "x-enum-ignore-case": true},
+    "identity": {"type": "string"},
+    "host": {"type": "string"},
+    "attachments": {"type": "array"},
+    "arguments": {"description": "the values of the templates"}
+  },
+  "anyOf": [{"required": ["content"]}, {"required": ["content_text"]}, {"required": ["content_html"]}],
+  "x-anyOf-message": "content, content_text or content_html is required"
+}`
+
 func init() {
 	Handlers["mail"] = newMailHandler
 	Handlers["mail_command"] = newMailHandler
 	Handlers["smtp"] = newMailHandler
 	Handlers["smtp_command"] = newMailHandler
+	RegisterHandlerSchema(mail_schema, "mail", "mail_command", "smtp", "smtp_command")
+
+	// 邮件的配置在发送时通过 configValue 读取， 修改后马上生效
+	onConfigChanged([]string{"mail.*"}, nil)
 }
@@ -37,6 +37,7 @@ type MailMessage struct {
 	ContentText string
 	ContentHtml string
 	Attachments []Attachment
+	Headers     map[string]string
 }
 
 type Attachment struct {
@@ -81,6 +82,9 @@ func (self *MailMessage) Bytes() ([]byte, error) {
 	write("Bcc: ", self.Bcc)
 	fmt.Fprintf(buf, "Date: %s%s", time.Now().UTC().Format(time.RFC1123Z), crlf)
 	fmt.Fprintf(buf, "Subject: %s%s", encodeSubject(self.Subject), crlf)
+	for k, v := range self.Headers {
+		fmt.Fprintf(buf, "%s: %s%s", k, v, crlf)
+	}
 
 	var parts *Multipart
 	var alternative *Multipart
@@ -125,7 +129,7 @@ func (self *MailMessage) Send(smtpServer string, auth smtp.Auth, useTLS smtp.TLS
 	}
 
 	if 0 == len(smtpServer) {
-		smtpServer = *defaultSmtpServer
+		smtpServer = configString("mail.smtp_server")
 		if 0 == len(smtpServer) {
 			return errors.New("'smtp_server' is missing or default 'smtp_server' is not set")
 		}
@@ -164,7 +168,7 @@ func (self *MailMessage) Send(smtpServer string, auth smtp.Auth, useTLS smtp.TLS
 }
 
 func encodeSubject(txt string) string {
-	switch *default_mail_subject_encoding {
+	switch configString("mail.subject_encoding") {
 	case "gb2312_base64":
 		return base64StringWithGB2312(txt)
 	case "gb2312_qp":
@@ -0,0 +1,318 @@
+package delayed_job
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// the metrics are exported in the prometheus text format(version 0.0.4),
+// see https://prometheus.io/docs/instrumenting/exposition_formats/
+var (
+	default_duration_buckets = []float64{0.005, 0.01, 0.025, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30, 60, 300}
+
+	metrics_jobs_enqueued  = newCounterVec("delayed_job_jobs_enqueued_total", "the number of jobs that are enqueued.", "type", "queue")
+	metrics_jobs_succeeded = newCounterVec("delayed_job_jobs_succeeded_total", "the number of jobs that are performed successfully.", "type", "queue")
+	metrics_jobs_failed    = newCounterVec("delayed_job_jobs_failed_total", "the number of jobs that are failed permanently.", "type", "queue")
+	metrics_jobs_retried   = newCounterVec("delayed_job_jobs_retried_total", "the number of jobs that are rescheduled after a failure.", "type", "queue")
+	metrics_job_duration   = newHistogramVec("delayed_job_job_duration_seconds", "the time of performing a job.", default_duration_buckets, "type", "queue")
+	metrics_reserve        = newHistogramVec("delayed_job_reserve_duration_seconds", "the time of reserving a job from the database.", default_duration_buckets)
+
+	default_metrics = []metricsCollector{metrics_jobs_enqueued,
+		metrics_jobs_succeeded,
+		metrics_jobs_failed,
+		metrics_jobs_retried,
+		metrics_job_duration,
+		metrics_reserve}
+)
+
+type metricsCollector interface {
+	writeTo(w io.Writer)
+}
+
+func escapeLabelValue(s string) string {
+	if !strings.ContainsAny(s, "\\\"\n") {
+		return s
+	}
+	s = strings.Replace(s, "\\", "\\\\", -1)
+	s = strings.Replace(s, "\"", "\\\"", -1)
+	return strings.Replace(s, "\n", "\\n", -1)
+}
+
+func formatLabels(names, values []string, extra ...string) string {
+	if 0 == len(names) && 0 == len(extra) {
+		return ""
+	}
+
+	var buffer bytes.Buffer
+	buffer.WriteString("{")
+	for i, nm := range names {
+		if 0 != i {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString(nm)
+		buffer.WriteString("=\"")
+		buffer.WriteString(escapeLabelValue(values[i]))
+		buffer.WriteString("\"")
+	}
+	for i := 0; i+1 < len(extra); i += 2 {
+		if 0 != len(names) || 0 != i {
+			buffer.WriteString(",")
+		}
+		buffer.WriteString(extra[i])
+		buffer.WriteString("=\"")
+		buffer.WriteString(escapeLabelValue(extra[i+1]))
+		buffer.WriteString("\"")
+	}
+	buffer.WriteString("}")
+	return buffer.String()
+}
+
+func formatFloat(f float64) string {
+	switch {
+	case math.IsInf(f, 1):
+		return "+Inf"
+	case math.IsInf(f, -1):
+		return "-Inf"
+	case math.IsNaN(f):
+		return "NaN"
+	}
+	return strconv.FormatFloat(f, 'g', -1, 64)
+}
+
+func writeHeader(w io.Writer, name, help, typ string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+type counterVec struct {
+	name   string
+	help   string
+	labels []string
+
+	mu     sync.Mutex
+	values map[string]*counterValue
+}
+
+type counterValue struct {
+	labels []string
+	value  float64
+}
+
+func newCounterVec(name, help string, labels ...string) *counterVec {
+	return &counterVec{name: name, help: help, labels: labels, values: map[string]*counterValue{}}
+}
+
+func (self *counterVec) Add(delta float64, values ...string) {
+	key := strings.Join(values, "\xff")
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	v, ok := self.values[key]
+	if !ok {
+		v = &counterValue{labels: values}
+		self.values[key] = v
+	}
+	v.value += delta
+}
+
+func (self *counterVec) Inc(values ...string) {
+	self.Add(1, values...)
+}
+
+func (self *counterVec) writeTo(w io.Writer) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	writeHeader(w, self.name, self.help, "counter")
+	for _, key := range sortedKeys(self.values) {
+		v := self.values[key]
+		fmt.Fprintf(w, "%s%s %s\n", self.name, formatLabels(self.labels, v.labels), formatFloat(v.value))
+	}
+}
+
+type histogramVec struct {
+	name    string
+	help    string
+	labels  []string
+	buckets []float64
+
+	mu     sync.Mutex
+	values map[string]*histogramValue
+}
+
+type histogramValue struct {
+	labels []string
+	counts []uint64
+	count  uint64
+	sum    float64
+}
+
+func newHistogramVec(name, help string, buckets []float64, labels ...string) *histogramVec {
+	return &histogramVec{name: name, help: help, labels: labels, buckets: buckets, values: map[string]*histogramValue{}}
+}
+
+func (self *histogramVec) Observe(v float64, values ...string) {
+	key := strings.Join(values, "\xff")
+
+	self.mu.Lock()
+	defer self.mu.Unlock()
+	h, ok := self.values[key]
+	if !ok {
+		h = &histogramValue{labels: values, counts: make([]uint64, len(self.buckets))}
+		self.values[key] = h
+	}
+	for i, upper := range self.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+func (self *histogramVec) ObserveDuration(d time.Duration, values ...string) {
+	self.Observe(d.Seconds(), values...)
+}
+
+func (self *histogramVec) writeTo(w io.Writer) {
+	self.mu.Lock()
+	defer self.mu.Unlock()
+
+	writeHeader(w, self.name, self.help, "histogram")
+	for _, key := range sortedKeys(self.values) {
+		h := self.values[key]
+		for i, upper := range self.buckets {
+			fmt.Fprintf(w, "%s_bucket%s %d\n", self.name, formatLabels(self.labels, h.labels, "le", formatFloat(upper)), h.counts[i])
+		}
+		fmt.Fprintf(w, "%s_bucket%s %d\n", self.name, formatLabels(self.labels, h.labels, "le", "+Inf"), h.count)
+		fmt.Fprintf(w, "%s_sum%s %s\n", self.name, formatLabels(self.labels, h.labels), formatFloat(h.sum))
+		fmt.Fprintf(w, "%s_count%s %d\n", self.name, formatLabels(self.labels, h.labels), h.count)
+	}
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch values := m.(type) {
+	case map[string]*counterValue:
+		for k := range values {
+			keys = append(keys, k)
+		}
+	case map[string]*histogramValue:
+		for k := range values {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// writeGauge 输出一个 gauge 值， labels 为 name, value 交替的列表
+func writeGauge(w io.Writer, name string, value float64, labels ...string) {
+	fmt.Fprintf(w, "%s%s %s\n", name, formatLabels(nil, nil, labels...), formatFloat(value))
+}
+
+func writeQueueMetrics(w io.Writer, backend *dbBackend) error {
+	stats, e := backend.queueStats()
+	if nil != e {
+		return e
+	}
+
+	now := backend.db_time_now()
+	writeHeader(w, "delayed_job_queue_depth", "the number of jobs that are not failed in the queue.", "gauge")
+	for _, st := range stats {
+		writeGauge(w, "delayed_job_queue_depth", float64(st.count), "queue", st.queue)
+	}
+	writeHeader(w, "delayed_job_queue_failed", "the number of jobs that are failed in the queue.", "gauge")
+	for _, st := range stats {
+		writeGauge(w, "delayed_job_queue_failed", float64(st.failed), "queue", st.queue)
+	}
+	writeHeader(w, "delayed_job_queue_oldest_job_age_seconds", "the age of the oldest job that is ready to run in the queue.", "gauge")
+	for _, st := range stats {
+		age := float64(0)
+		if !st.oldest_run_at.IsZero() && now.After(st.oldest_run_at) {
+			age = now.Sub(st.oldest_run_at).Seconds()
+		}
+		writeGauge(w, "delayed_job_queue_oldest_job_age_seconds", age, "queue", st.queue)
+	}
+	return nil
+}
+
+func writeLimiterMetrics(w io.Writer) {
+	if nil == smsLimiter {
+		return
+	}
+
+	day, week, month := smsLimiter.Counts()
+	writeHeader(w, "delayed_job_sms_limiter_sent", "the number of sms that are sent in the period.", "gauge")
+	writeGauge(w, "delayed_job_sms_limiter_sent", float64(day), "period", "day")
+	writeGauge(w, "delayed_job_sms_limiter_sent", float64(week), "period", "week")
+	writeGauge(w, "delayed_job_sms_limiter_sent", float64(month), "period", "month")
+
+	day_limit, week_limit, month_limit := smsLimiter.Limits()
+	writeHeader(w, "delayed_job_sms_limiter_limit", "the limit of sms in the period, 0 is unlimited.", "gauge")
+	writeGauge(w, "delayed_job_sms_limiter_limit", float64(day_limit), "period", "day")
+	writeGauge(w, "delayed_job_sms_limiter_limit", float64(week_limit), "period", "week")
+	writeGauge(w, "delayed_job_sms_limiter_limit", float64(month_limit), "period", "month")
+
+	// open 表示限流已触发，短信将不再发送
+	open := float64(0)
+	if !smsLimiter.CanSend() {
+		open = 1
+	}
+	writeHeader(w, "delayed_job_sms_limiter_open", "1 if the sms limiter is tripped and sms are dropped.", "gauge")
+	writeGauge(w, "delayed_job_sms_limiter_open", open)
+}
+
+// writePausedMetrics 输出被暂停的队列， 它是队列级的开关， 没有执行 migrate 时不输出
+func writePausedMetrics(w io.Writer, backend *dbBackend) {
+	paused, e := backend.pausedQueues()
+	if nil != e {
+		return
+	}
+
+	queues := make([]string, 0, len(paused))
+	for queue := range paused {
+		queues = append(queues, queue)
+	}
+	sort.Strings(queues)
+	writeHeader(w, "delayed_job_queue_paused", "1 if the queue is paused and its jobs aren't reserved.", "gauge")
+	for _, queue := range queues {
+		writeGauge(w, "delayed_job_queue_paused", 1, "queue", queue)
+	}
+}
+
+// writeMetrics 输出全部的指标， 限流和熔断的状态只有 sms 限流和暂停的队列， handler 本身没有熔断器
+func writeMetrics(w io.Writer, backend *dbBackend) error {
+	for _, c := range default_metrics {
+		c.writeTo(w)
+	}
+
+	writeLimiterMetrics(w)
+
+	if nil != backend {
+		writePausedMetrics(w, backend)
+		return writeQueueMetrics(w, backend)
+	}
+	return nil
+}
+
+func metricsHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	var buffer bytes.Buffer
+	e := writeMetrics(&buffer, backend)
+	if nil != e {
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	w.Header()["Content-Type"] = []string{"text/plain; version=0.0.4; charset=utf-8"}
+	w.Write(buffer.Bytes())
+}
@@ -0,0 +1,85 @@
+package delayed_job
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetricsCounter(t *testing.T) {
+	c := newCounterVec("test_total", "for test", "type", "queue")
+	c.Inc("mail", "aa")
+	c.Inc("mail", "aa")
+	c.Add(3, "sms", "b\"b")
+
+	var buffer bytes.Buffer
+	c.writeTo(&buffer)
+
+	for _, excepted := range []string{"# TYPE test_total counter",
+		`test_total{type="mail",queue="aa"} 2`,
+		`test_total{type="sms",queue="b\"b"} 3`} {
+		if !strings.Contains(buffer.String(), excepted) {
+			t.Error("excepted contains", excepted, ", actual is", buffer.String())
+		}
+	}
+}
+
+func TestMetricsHistogram(t *testing.T) {
+	h := newHistogramVec("test_seconds", "for test", []float64{0.1, 1}, "type")
+	h.ObserveDuration(50*time.Millisecond, "web")
+	h.ObserveDuration(500*time.Millisecond, "web")
+	h.ObserveDuration(5*time.Second, "web")
+
+	var buffer bytes.Buffer
+	h.writeTo(&buffer)
+
+	for _, excepted := range []string{"# TYPE test_seconds histogram",
+		`test_seconds_bucket{type="web",le="0.1"} 1`,
+		`test_seconds_bucket{type="web",le="1"} 2`,
+		`test_seconds_bucket{type="web",le="+Inf"} 3`,
+		`test_seconds_sum{type="web"} 5.55`,
+		`test_seconds_count{type="web"} 3`} {
+		if !strings.Contains(buffer.String(), excepted) {
+			t.Error("excepted contains", excepted, ", actual is", buffer.String())
+		}
+	}
+}
+
+func TestMetricsHistogramWithoutLabels(t *testing.T) {
+	h := newHistogramVec("test_seconds", "for test", []float64{1})
+	h.Observe(0.5)
+
+	var buffer bytes.Buffer
+	h.writeTo(&buffer)
+
+	for _, excepted := range []string{`test_seconds_bucket{le="1"} 1`,
+		`test_seconds_sum 0.5`,
+		`test_seconds_count 1`} {
+		if !strings.Contains(buffer.String(), excepted) {
+			t.Error("excepted contains", excepted, ", actual is", buffer.String())
+		}
+	}
+}
+
+func TestMetricsPausedQueues(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		defer backend.resumeQueue("aa")
+		if _, e := backend.pauseQueue("aa", "test"); nil != e {
+			t.Error(e)
+			return
+		}
+
+		var buffer bytes.Buffer
+		if e := writeMetrics(&buffer, backend); nil != e {
+			t.Error(e)
+			return
+		}
+		for _, excepted := range []string{"# TYPE delayed_job_queue_paused gauge",
+			`delayed_job_queue_paused{queue="aa"} 1`} {
+			if !strings.Contains(buffer.String(), excepted) {
+				t.Error("excepted contains", excepted, ", actual is", buffer.String())
+			}
+		}
+	})
+}
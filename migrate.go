@@ -0,0 +1,47 @@
+package delayed_job
+
+import (
+	"flag"
+	"fmt"
+	"io"
+)
+
+var auto_migrate = flag.Bool("db.auto_migrate", true, "create the missing tables(audit, idempotency, history and paused queues) at startup, the jobs are kept")
+
+// migrate 创建缺少的辅助表， 已有的表和任务不会被修改， 执行的脚本输出到 w 中
+func (self *dbBackend) migrate(w io.Writer) error {
+	for _, table := range []struct {
+		scripts func(dbType int) []string
+		init    func() error
+	}{{auditTableScripts, self.initAuditTable},
+		{idempotencyTableScripts, self.initIdempotencyTable},
+		{historyTableScripts, self.initHistoryTable},
+		{pausedQueuesTableScripts, self.initPausedQueuesTable}} {
+		if nil != w {
+			for _, script := range table.scripts(self.dbType) {
+				fmt.Fprintln(w, script)
+			}
+		}
+		if e := table.init(); nil != e {
+			return e
+		}
+	}
+	return nil
+}
+
+// autoMigrate 启动时创建缺少的表， 失败时（如没有建表的权限）只记录警告
+func autoMigrate(dbDrv, dbURL string) {
+	if !*auto_migrate {
+		return
+	}
+	backend, e := newBackend(dbDrv, dbURL, map[string]interface{}{})
+	if nil != e {
+		logger.Warn("create the missing tables failed", "error", e)
+		return
+	}
+	defer backend.Close()
+
+	if e := backend.migrate(nil); nil != e {
+		logger.Warn("create the missing tables failed, run '-mode migrate' by a db user which can create tables", "error", e)
+	}
+}
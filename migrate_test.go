@@ -0,0 +1,43 @@
+package delayed_job
+
+import (
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestMigrate(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		e := backend.enqueue(1, 0, "", 1, "aa", time.Time{}, map[string]interface{}{"type": "test"})
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if _, e := backend.db.Exec("DROP TABLE " + pausedQueuesTableName()); nil != e {
+			t.Error(e)
+			return
+		}
+
+		if e := Main("migrate", GetTestConnDrv(), GetTestConnURL(), func(http.Handler) {}); nil != e {
+			t.Error(e)
+			return
+		}
+
+		var count int64
+		if e := backend.db.QueryRow("SELECT count(*) FROM " + *table_name).Scan(&count); nil != e {
+			t.Error(e)
+			return
+		}
+		if 1 != count {
+			t.Error("jobs are deleted, actual count is", count)
+		}
+		if _, e := backend.pausedQueues(); nil != e {
+			t.Error("paused queues table isn't created,", e)
+		}
+
+		// 重复执行不会出错
+		if e := backend.migrate(nil); nil != e {
+			t.Error(e)
+		}
+	})
+}
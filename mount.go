@@ -0,0 +1,114 @@
+package delayed_job
+
+import (
+	"errors"
+	"net/http"
+)
+
+// AuthOptions 是 HTTPHandler 的认证选项， 格式与 auth.tokens 和 auth.users 相同
+type AuthOptions struct {
+	// Tokens 是 api token， 每个的格式为 'name:role:token'
+	Tokens []string
+	// Users 是 http basic auth 的用户， 每个的格式为 'name:role:bcrypt_hash'
+	Users []string
+	// AnonymousRole 是没有凭证的请求的角色， 为空时拒绝这些请求
+	AnonymousRole string
+}
+
+// HandlerOptions 是 NewHTTPHandler 的选项
+type HandlerOptions struct {
+	DbDrv string
+	DbURL string
+
+	// Prefix 是挂载的路径， 例如 "/ops/jobs/"， 为空时使用 http.base_path 的值
+	Prefix string
+
+	// AssetsDir 中的文件会覆盖内嵌的 web 界面的同名文件， 为空时使用 http.assets_dir 的值
+	AssetsDir string
+
+	// Auth 为 nil 时使用 auth.* 的配置
+	Auth *AuthOptions
+}
+
+// HTTPHandler 是 web 界面和 api， 可以挂载到自己的 http.ServeMux 中， 例如
+//
+//	h, e := delayed_job.NewHTTPHandler(delayed_job.HandlerOptions{DbDrv: "postgres", DbURL: url, Prefix: "/ops/jobs/"})
+//	if nil != e {
+//		return e
+//	}
+//	defer h.Close()
+//	mux.Handle("/ops/jobs/", h)
+type HTTPHandler struct {
+	front *webFront
+}
+
+func (self *HTTPHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	self.front.ServeHTTP(w, r)
+}
+
+// Close 关闭数据库连接
+func (self *HTTPHandler) Close() error {
+	return self.front.dbBackend.Close()
+}
+
+func newAuthenticatorFrom(options *AuthOptions) (*authenticator, error) {
+	if nil == options {
+		return newAuthenticator()
+	}
+
+	auth := &authenticator{users: map[string]credential{}, mtls_users: map[string]credential{}}
+	for _, token := range options.Tokens {
+		if e := auth.add("token", token); nil != e {
+			return nil, e
+		}
+	}
+	for _, user := range options.Users {
+		if e := auth.add("user", user); nil != e {
+			return nil, e
+		}
+	}
+	if "" != options.AnonymousRole {
+		role, e := parseRole(options.AnonymousRole)
+		if nil != e {
+			return nil, errors.New("anonymous role is invalid, " + e.Error())
+		}
+		auth.anonymous_role = role
+	}
+	return auth, nil
+}
+
+// NewHTTPHandler 创建 web 界面和 api 的 http.Handler， 它不会启动 worker， 任务由其它进程执行
+func NewHTTPHandler(options HandlerOptions) (*HTTPHandler, error) {
+	initDB()
+
+	auth, e := newAuthenticatorFrom(options.Auth)
+	if nil != e {
+		return nil, e
+	}
+
+	assetsDir := options.AssetsDir
+	if "" == assetsDir {
+		assetsDir = *assets_dir
+	}
+	assets, e := assetsFS(assetsDir)
+	if nil != e {
+		return nil, e
+	}
+
+	prefix := options.Prefix
+	if "" == prefix {
+		prefix = *base_path
+	}
+
+	ctx := map[string]interface{}{}
+	backend, e := newBackend(options.DbDrv, options.DbURL, ctx)
+	if nil != e {
+		return nil, e
+	}
+	ctx["backend"] = backend
+
+	return &HTTPHandler{front: &webFront{dbBackend: backend,
+		fs:        http.FileServer(http.FS(assets)),
+		auth:      auth,
+		base_path: normalizeBasePath(prefix)}}, nil
+}
@@ -0,0 +1,87 @@
+package delayed_job
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/runner-mei/delayed_job/client"
+)
+
+func TestNewAuthenticatorFrom(t *testing.T) {
+	auth, e := newAuthenticatorFrom(&AuthOptions{Tokens: []string{"ci:producer:abc"}, AnonymousRole: "viewer"})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 1 != len(auth.tokens) || role_producer != auth.tokens[0].role || role_viewer != auth.anonymous_role {
+		t.Error("actual is", auth.tokens, auth.anonymous_role)
+	}
+
+	if _, e := newAuthenticatorFrom(&AuthOptions{AnonymousRole: "root"}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+	if _, e := newAuthenticatorFrom(&AuthOptions{Tokens: []string{"abc"}}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestHTTPHandler(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		h, e := NewHTTPHandler(HandlerOptions{DbDrv: GetTestConnDrv(),
+			DbURL:  GetTestConnURL(),
+			Prefix: "/ops/jobs",
+			Auth:   &AuthOptions{Tokens: []string{"ci:admin:abc"}}})
+		if nil != e {
+			t.Fatal(e)
+		}
+		defer h.Close()
+
+		mux := http.NewServeMux()
+		mux.Handle("/ops/jobs/", h)
+		srv := httptest.NewServer(mux)
+		defer srv.Close()
+
+		ctx := context.Background()
+		if _, e := client.New(srv.URL + "/ops/jobs").Counts(ctx); nil == e {
+			t.Error("excepted unauthorized, actual is nil")
+		}
+
+		c := client.New(srv.URL+"/ops/jobs/", client.WithToken("abc"))
+		created, e := c.Push(ctx, &client.Job{Queue: "mount", Handler: client.Raw{"type": "test", "content": "a"}})
+		if nil != e {
+			t.Fatal(e)
+		}
+
+		job, e := c.Get(ctx, created.Id)
+		if nil != e {
+			t.Fatal(e)
+		}
+		if "mount" != job.Queue || created.HandlerId != job.HandlerId {
+			t.Error("actual is", *job)
+		}
+
+		jobs, _, e := c.List(ctx, &client.ListOptions{Queue: "mount"})
+		if nil != e {
+			t.Fatal(e)
+		}
+		if 1 != len(jobs) || created.Id != jobs[0].Id {
+			t.Error("actual is", jobs)
+		}
+
+		counts, e := c.Counts(ctx)
+		if nil != e {
+			t.Fatal(e)
+		}
+		if 1 != counts.All || 1 != counts.Queued {
+			t.Error("actual is", *counts)
+		}
+
+		if e := c.Cancel(ctx, created.Id); nil != e {
+			t.Error(e)
+		}
+		if _, e := c.Get(ctx, created.Id); !client.IsNotFound(e) {
+			t.Error("excepted not found, actual is", e)
+		}
+	})
+}
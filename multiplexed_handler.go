@@ -36,7 +36,7 @@ func newMultiplexedHandler(ctx, params map[string]interface{}) (Handler, error)
 	is_valid_rule := boolWithDefault(params, "is_valid_rule", false)
 	gpriority := intWithDefault(params, "priority", *default_priority)
 	gqueue := stringWithDefault(params, "queue", *default_queue_name)
-	gmax_attempts := intWithDefault(params, "max_attempts", *default_max_attempts)
+	gmax_attempts := intWithDefault(params, "max_attempts", configInt("max_attempts"))
 	grun_at := timeWithDefault(params, "run_at", time.Time{})
 	args := params["arguments"]
 
@@ -101,6 +101,19 @@ func (self *multiplexedHandler) Perform() error {
 	return self.backend.create(self.rules...)
 }
 
+// Preview 返回每个子任务的预览
+func (self *multiplexedHandler) Preview() (map[string]interface{}, error) {
+	rules := make([]interface{}, 0, len(self.rules))
+	for idx, job := range self.rules {
+		preview, e := previewHandler(job)
+		if nil != e {
+			return nil, fmt.Errorf("rules[%d] is invalid, %v", idx, e)
+		}
+		rules = append(rules, map[string]interface{}{"type": job.handlerType(), "preview": preview})
+	}
+	return map[string]interface{}{"rules": rules}, nil
+}
+
 func init() {
 	Handlers["multiplexed"] = newMultiplexedHandler
 }
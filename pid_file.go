@@ -4,7 +4,6 @@ import (
 	"flag"
 	"fmt"
 	"io/ioutil"
-	"log"
 	"os"
 	"path/filepath"
 	"runtime"
@@ -48,7 +47,7 @@ func createPidFile(pidFile, image string) error {
 	file, err := os.Create(pidFile)
 	if err != nil {
 		if e := os.MkdirAll(filepath.Dir(pidFile), 0666); e != nil {
-			log.Println("[warn] mkdir '"+filepath.Dir(pidFile)+"' fail:", e)
+			logger.Warn("create the directory of pid file failed", "dir", filepath.Dir(pidFile), "error", e)
 		}
 		file, err = os.Create(pidFile)
 		if err != nil {
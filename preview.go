@@ -0,0 +1,125 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// Previewer 由支持预览的 handler 实现， 返回渲染后的最终内容， 预览不能有任何副作用
+type Previewer interface {
+	Preview() (map[string]interface{}, error)
+}
+
+var errPreviewUnsupported = errors.New("preview is unsupported by the handler")
+
+type previewError struct {
+	status int
+	err    error
+}
+
+func (self *previewError) Error() string {
+	return self.err.Error()
+}
+
+// previewJob 按 /test 的格式读取任务并渲染， 但不会执行它
+func previewJob(backend *dbBackend, r io.Reader) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(r)
+	decoder.UseNumber()
+	var ent map[string]interface{}
+	if e := decoder.Decode(&ent); nil != e {
+		return nil, &previewError{http.StatusBadRequest, e}
+	}
+
+	job, e := createJobFromMap(backend, ent)
+	if nil != e {
+		if _, ok := e.(*validationError); ok {
+			return nil, e
+		}
+		return nil, &previewError{http.StatusUnprocessableEntity, e}
+	}
+	result, e := previewHandler(job)
+	if nil != e {
+		return nil, e
+	}
+	return map[string]interface{}{"type": job.handlerType(), "preview": result}, nil
+}
+
+func previewHandler(job *Job) (map[string]interface{}, error) {
+	handler, e := job.payload_object()
+	if nil != e {
+		return nil, &previewError{http.StatusUnprocessableEntity, e}
+	}
+	previewer, ok := handler.(Previewer)
+	if !ok {
+		return nil, &previewError{http.StatusNotImplemented, errPreviewUnsupported}
+	}
+	result, e := previewer.Preview()
+	if nil != e {
+		return nil, &previewError{http.StatusUnprocessableEntity, e}
+	}
+	return result, nil
+}
+
+func previewStatus(e error) int {
+	switch pe := e.(type) {
+	case *previewError:
+		return pe.status
+	case *validationError:
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+// previewHTTPRequest 将请求转换为预览的内容， 会读取请求的 body
+func previewHTTPRequest(req *http.Request) (map[string]interface{}, error) {
+	headers := map[string]interface{}{}
+	for k := range req.Header {
+		headers[k] = req.Header.Get(k)
+	}
+
+	preview := map[string]interface{}{"method": req.Method,
+		"url":          redactText(req.URL.Redacted()),
+		"request_line": redactText(req.Method + " " + req.URL.RequestURI() + " HTTP/1.1"),
+		"headers":      redactMap(headers)}
+	if nil != req.Body {
+		bs, e := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if nil != e {
+			return nil, e
+		}
+		preview["body"] = string(bs)
+	}
+	return preview, nil
+}
+
+func previewJobHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	result, e := previewJob(backend, r.Body)
+	if ve, ok := e.(*validationError); ok {
+		writeValidationError(w, ve)
+		return
+	}
+	if nil != e {
+		w.WriteHeader(previewStatus(e))
+		io.WriteString(w, e.Error())
+		return
+	}
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
+func apiPreviewJob(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	result, e := previewJob(backend, r.Body)
+	if ve, ok := e.(*validationError); ok {
+		writeValidationError(w, ve)
+		return
+	}
+	if nil != e {
+		writeAPIErrorWithStatus(w, previewStatus(e), e)
+		return
+	}
+	writeJSON(w, http.StatusOK, result)
+}
@@ -0,0 +1,206 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/base64"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+)
+
+type noPreviewHandler struct{}
+
+func (self noPreviewHandler) Perform() error {
+	return nil
+}
+
+func TestPreviewJob(t *testing.T) {
+	backend := &dbBackend{}
+	result, e := previewJob(backend, strings.NewReader(`{"handler": {"type": "test", "content": "hello"}}`))
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	preview, _ := result["preview"].(map[string]interface{})
+	if "test" != result["type"] || "hello" != preview["content"] {
+		t.Error("result is invalid -", result)
+	}
+
+	Handlers["no_preview"] = func(ctx, options map[string]interface{}) (Handler, error) {
+		return noPreviewHandler{}, nil
+	}
+	defer delete(Handlers, "no_preview")
+
+	for body, status := range map[string]int{`abc`: http.StatusBadRequest,
+		`{"handler": {"type": "abc"}}`:        http.StatusUnprocessableEntity,
+		`{"handler": {"type": "no_preview"}}`: http.StatusNotImplemented} {
+		_, e := previewJob(backend, strings.NewReader(body))
+		if nil == e {
+			t.Error("excepted error for", body)
+		} else if status != previewStatus(e) {
+			t.Error("excepted status is", status, ", actual is", previewStatus(e), "for", body)
+		}
+	}
+}
+
+func TestPreviewJobHandler(t *testing.T) {
+	req := httptest.NewRequest("POST", "/test?dry_run=true", bytes.NewBufferString(`{"handler": {"type": "test", "content": "hello"}}`))
+	w := httptest.NewRecorder()
+	testJobHandler(w, req, &dbBackend{})
+	if http.StatusOK != w.Code {
+		t.Error("excepted status is 200, actual is", w.Code, w.Body.String())
+	}
+	if !strings.Contains(w.Body.String(), `"content":"hello"`) {
+		t.Error("body is invalid -", w.Body.String())
+	}
+
+	select {
+	case <-test_chan:
+		t.Error("job is performed")
+	default:
+	}
+}
+
+func TestWebHandlerPreview(t *testing.T) {
+	handler, e := newWebHandler(nil, map[string]interface{}{"method": "POST",
+		"url":          "http://127.0.0.1/notify?id={{.id}}",
+		"content_type": "application/json",
+		"head.X-Id":    "{{.id}}",
+		"body":         `{"name": "{{.name}}"}`,
+		"arguments":    map[string]interface{}{"id": "12", "name": "abc"}})
+	if nil != e {
+		t.Error(e)
+		return
+	}
+
+	preview, e := handler.(Previewer).Preview()
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	requests, _ := preview["requests"].([]interface{})
+	if 1 != len(requests) {
+		t.Error("excepted requests is 1, actual is", preview)
+		return
+	}
+	req := requests[0].(map[string]interface{})
+	if "POST /notify?id=12 HTTP/1.1" != req["request_line"] || `{"name": "abc"}` != req["body"] {
+		t.Error("request is invalid -", req)
+	}
+	if headers, _ := req["headers"].(map[string]interface{}); "12" != headers["X-Id"] || "application/json" != headers["Content-Type"] {
+		t.Error("headers is invalid -", req["headers"])
+	}
+}
+
+func TestPreviewHTTPRequestRedacted(t *testing.T) {
+	req := httptest.NewRequest("GET", "http://127.0.0.1/notify?user=a&password=abc", nil)
+	preview, e := previewHTTPRequest(req)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if line, _ := preview["request_line"].(string); strings.Contains(line, "abc") {
+		t.Error("request_line isn't redacted -", line)
+	}
+	redacted := redactMap(map[string]interface{}{"requests": []interface{}{map[string]interface{}{"body": "password=abc"}}})
+	if body := redacted["requests"].([]interface{})[0].(map[string]interface{})["body"]; "password="+redacted_text != body {
+		t.Error("body isn't redacted -", body)
+	}
+}
+
+func TestExecHandlerPreview(t *testing.T) {
+	handler, e := newExecHandler(map[string]interface{}{}, map[string]interface{}{"command": `echo "a b" {{.name}}`,
+		"arguments": map[string]interface{}{"name": "abc"}})
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	preview, e := handler.(Previewer).Preview()
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if !strings.HasSuffix(preview["command_line"].(string), `"a b" abc`) {
+		t.Error("command_line is invalid -", preview["command_line"])
+	}
+}
+
+func TestMailHandlerPreview(t *testing.T) {
+	handler, e := newMailHandler(map[string]interface{}{}, map[string]interface{}{"smtp_server": "127.0.0.1:25",
+		"from_address": "from@example.com",
+		"to_address":   "to@example.com",
+		"subject":      "hello {{.name}}",
+		"content":      "content of {{.name}}",
+		"arguments":    map[string]interface{}{"name": "abc"}})
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	preview, e := handler.(Previewer).Preview()
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if "hello abc" != preview["subject"] || "content of abc" != preview["content_text"] {
+		t.Error("preview is invalid -", preview)
+	}
+	if mime, _ := preview["mime"].(string); !strings.Contains(mime, "to@example.com") {
+		t.Error("mime is invalid -", mime)
+	}
+}
+
+func TestMailHandlerPreviewAttachments(t *testing.T) {
+	f, e := ioutil.TempFile("", "delayed_job")
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("secret of the server")
+	f.Close()
+
+	handler, e := newMailHandler(map[string]interface{}{}, map[string]interface{}{"smtp_server": "127.0.0.1:25",
+		"from_address": "from@example.com",
+		"to_address":   "to@example.com",
+		"subject":      "hello",
+		"content":      "content",
+		"attachments": []interface{}{map[string]interface{}{"name": "a.txt", "file": f.Name()},
+			"/not_exists/b.txt"}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	preview, e := handler.(Previewer).Preview()
+	if nil != e {
+		t.Fatal(e)
+	}
+	if attachments, _ := preview["attachments"].([]string); 2 != len(attachments) || "a.txt" != attachments[0] || "b.txt" != attachments[1] {
+		t.Error("attachments is invalid -", preview["attachments"])
+	}
+	mime, _ := preview["mime"].(string)
+	encoded := base64.StdEncoding.EncodeToString([]byte("secret of the server"))
+	if strings.Contains(mime, "secret of the server") || strings.Contains(mime, encoded) {
+		t.Error("content of the attachment is previewed -", mime)
+	}
+	if !strings.Contains(mime, "a.txt") {
+		t.Error("mime is invalid -", mime)
+	}
+}
+
+func TestSyslogHandlerPreview(t *testing.T) {
+	handler, e := newSyslogHandler(map[string]interface{}{}, map[string]interface{}{"to_address": "127.0.0.1",
+		"content":   "hello {{.name}}",
+		"arguments": map[string]interface{}{"name": "abc"}})
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	preview, e := handler.(Previewer).Preview()
+	if nil != e {
+		t.Error(e)
+		return
+	}
+	if message, _ := preview["message"].(string); !strings.HasSuffix(message, "hello abc") {
+		t.Error("message is invalid -", message)
+	}
+}
@@ -6,50 +6,379 @@
 
 $(function(){
 
+  function showMessage(level, data) {
+    var template = $('#dj_message_template').html();
+    $('#dj-message-view').html(Mustache.render(template, {level: level, data: data}));
+  }
+
+  function errorMessage(resp) {
+    var result = {};
+    try { result = JSON.parse(resp.responseText); } catch(e) {}
+    if (result.error && result.error.errors) {
+      return $.map(result.error.errors, function(fe){ return fe.field + ' ' + fe.message; }).join('; ');
+    }
+    return (result.error && result.error.message) || resp.responseText;
+  }
+
+  function escapeHTML(s) {
+    return String(s).replace(/&/g, '&amp;').replace(/</g, '&lt;').replace(/>/g, '&gt;').replace(/"/g, '&quot;');
+  }
+
+  function reloadActiveTab() {
+    $('.nav.nav-tabs li.active a[data-toggle="tab"]').trigger('shown');
+  }
+
+  // ---------------------------------------------------------------- jobs
+
+  function loadPage(tabContent, cursor) {
+    if (tabContent.attr('id') == 'dashboard') {
+      loadDashboard();
+      return;
+    }
+
+    var params = {};
+    if (tabContent.data('page-size'))
+      params.limit = tabContent.data('page-size');
+    if (cursor)
+      params.cursor = cursor;
+    if (tabContent.attr('id') != 'audit') {
+      var q = $.trim($('#dj-search').val());
+      if (q) params.q = q;
+      if (tabContent.data('queue')) params.queue = tabContent.data('queue');
+    }
+
+    $.getJSON(tabContent.data('url'), params).success(function(data, status, xhr){
+      var template = $('#' + (tabContent.data('template') || 'dj_reports_template')).html();
+      $.each(data || [], function(i, item){
+        if(item.before) item.before_json = JSON.stringify(item.before);
+        if(item.after) item.after_json = JSON.stringify(item.after);
+        if(item.last_error) item.last_error_summary = item.last_error.length > 40 ? item.last_error.substring(0, 40) + '...' : item.last_error;
+      });
+
+      // 下一页追加到表格的末尾
+      if (cursor) {
+        tabContent.find('tbody').append($(Mustache.render(template, data)).find('tbody').children());
+      } else if(!! data && data.length > 0) {
+        tabContent.html(Mustache.render(template, data));
+      } else {
+        tabContent.html("<div class='alert centered'>" + (tabContent.data('empty') || 'No Jobs') + "</div>");
+      }
+
+      tabContent.find('.load-more').remove();
+      var next = xhr.getResponseHeader('X-Next-Cursor');
+      if (next) {
+        $("<button class='btn load-more'>More</button>").appendTo(tabContent).click(function(){
+          loadPage(tabContent, next);
+        });
+      }
+    });
+  }
+
   $('a[data-toggle="tab"]').bind('shown', function(e) {
     var currentTab = e.target;
-    var tabContent = $($(currentTab).attr('href'));
-    var dataUrl = tabContent.data('url');
-
-    $.getJSON(dataUrl).success(function(data){
-      var template = $('#dj_reports_template').html();
-      if(!! data && data.length > 0)
-        var output = Mustache.render(template, data);
-      else
-        var output = "<div class='alert centered'>No Jobs</div>";
-      tabContent.html(output);
-
-
-
-      $('form').submit(function(){
-          $.ajax({
-           url: $(this).attr('action'),
-           type:'post',           //数据发送方式
-           dataType:'json',      //接受数据格式
-           data: {},             //要传递的数据
-           complete:function(resp){
-            var params = {level: "success",  data: resp.responseText}
-            if (resp.status != 200) {
-              params.level = "warning"
-            }
-
-            var template = $('#dj_message_template').html();
-            var output = Mustache.render(template, params);
-            $('#dj-message-view').html(output);
-
-            $('[data-dismiss="alert"]').live('click', function(){
-              $('.alert').hide().remove();
-            });
-
-           } //回传函数(这里是函数名)
-         });
-         return false
+    var pane = $($(currentTab).attr('href'));
+    var isJobs = pane.attr('id') != 'dashboard' && pane.attr('id') != 'audit';
+    $('#dj-bulk-toolbar, #dj-search-form').toggle(isJobs);
+    loadPage(pane);
+  })
+
+  $('#dj-search-form').submit(function(){
+    reloadActiveTab();
+    return false;
+  });
+
+  $('#dj-search-clear').click(function(){
+    $('#dj-search').val('');
+    $('.tab-pane').removeData('queue');
+    reloadActiveTab();
+    return false;
+  });
+
+  $('#dj-bulk-toolbar button').click(function(){
+    var action = $(this).data('bulk-action');
+    var body = {action: action};
+    if ($(this).data('bulk-filter')) {
+      body.filter = {state: $(this).data('bulk-filter')};
+    } else {
+      body.ids = $('.tab-pane.active .job-select:checked').map(function(){
+        return parseInt($(this).val(), 10);
+      }).get();
+      if (body.ids.length == 0) {
+        showMessage("warning", "No jobs are selected");
+        return false;
+      }
+    }
+    if (action == 'move')
+      body.queue = $('#dj-bulk-queue').val();
+    if (action == 'reprioritize')
+      body.priority = parseInt($('#dj-bulk-priority').val(), 10);
+
+    if ((action == 'delete' || body.filter) && !confirm('Are you sure to ' + $(this).text().toLowerCase() + '?'))
+      return false;
+
+    $.ajax({
+      url: 'api/v2/jobs/bulk',
+      type: 'post',
+      contentType: 'application/json',
+      data: JSON.stringify(body),
+      complete: function(resp){
+        if (resp.status != 200) {
+          showMessage("warning", errorMessage(resp));
+        } else {
+          var result = JSON.parse(resp.responseText);
+          showMessage("success", result.matched + " matched, " + result.succeeded + " succeeded, " +
+            result.skipped + " skipped, " + result.failed + " failed");
+        }
+        reloadActiveTab();
+      }
+    });
+    return false;
+  });
+
+  // 任务的操作： retry 重新执行失败的任务， delete 取消（删除）任务
+  $('.job-action').live('click', function(){
+    var id = $(this).data('id');
+    var action = $(this).data('action');
+    if (action == 'delete' && !confirm('Are you sure to cancel the job ' + id + '?'))
+      return false;
+
+    $.ajax({
+      url: 'api/v2/jobs/' + id + (action == 'retry' ? '/retry' : ''),
+      type: action == 'retry' ? 'post' : 'delete',
+      complete: function(resp){
+        if (resp.status >= 300) {
+          showMessage("warning", errorMessage(resp));
+          return;
+        }
+        $('.modal').hide().remove();
+        showMessage("success", action == 'retry' ? "The job " + id + " will be retried" : "The job " + id + " was cancelled");
+        reloadActiveTab();
+      }
+    });
+    return false;
+  });
+
+  $('a.edit-job').live('click', function(){
+    $.getJSON('api/v2/jobs/' + $(this).data('id')).success(function(job){
+      var handler = job.handler;
+      try { handler = JSON.parse(job.handler); } catch(e) {}
+      var content = JSON.stringify({handler: handler,
+        priority: job.priority,
+        queue: job.queue,
+        run_at: job.run_at,
+        max_attempts: job.max_attempts}, null, 2);
+      var template = $('#dj_edit_template').html();
+      $('.modal').hide().remove();
+      $(Mustache.render(template, {id: job.id, content: content})).appendTo($('body')).show();
+    });
+    return false;
+  });
+
+  $('#dj-edit-save').live('click', function(){
+    var body;
+    try {
+      body = JSON.parse($('#dj-edit-content').val());
+    } catch(e) {
+      alert('JSON is invalid, ' + e);
+      return false;
+    }
+    body.reset = $('#dj-edit-reset').is(':checked');
+
+    $.ajax({
+      url: 'api/v2/jobs/' + $(this).data('id'),
+      type: 'patch',
+      contentType: 'application/json',
+      data: JSON.stringify(body),
+      complete: function(resp){
+        if (resp.status != 200) {
+          alert(errorMessage(resp));
+          return;
+        }
+        $('.modal').hide().remove();
+        showMessage("success", "The job was updated");
+        reloadActiveTab();
+      }
+    });
+    return false;
+  });
+
+  // ---------------------------------------------------------------- job detail
+
+  var attemptLabels = {succeeded: 'success', retried: 'warning', failed: 'important'};
+
+  function jobStatus(job) {
+    if (job.failed) return 'failed';
+    if (job.locked_by) return 'running';
+    return 'queued';
+  }
+
+  // 详情由任务、 渲染后的内容和执行记录三个请求组成， 后两个失败时只显示错误， 不影响任务本身的显示
+  $('a.job-detail').live('click', function(){
+    var id = $(this).data('id');
+    var view = {};
+    $.when(
+      $.getJSON('api/v2/jobs/' + id),
+      $.getJSON('api/v2/jobs/' + id + '/preview').pipe(function(data){
+        return data;
+      }, function(resp){
+        return $.Deferred().resolve({error: errorMessage(resp)});
+      }),
+      $.getJSON('api/v2/jobs/' + id + '/attempts').pipe(function(data){
+        return data;
+      }, function(resp){
+        return $.Deferred().resolve([]);
+      })
+    ).done(function(jobResult, preview, attempts){
+      var job = jobResult[0];
+      var handler = job.handler;
+      try { handler = JSON.stringify(JSON.parse(job.handler), null, 2); } catch(e) {}
+
+      view.job = job;
+      view.status = jobStatus(job);
+      view.handler = handler;
+      view.type = preview.type || '';
+      if (preview.preview) {
+        view.preview = JSON.stringify(preview.preview, null, 2);
+      } else {
+        view.preview_error = preview.error || 'preview is unavailable';
+      }
+      view.attempts = $.map(attempts || [], function(a){
+        a.label = attemptLabels[a.status] || 'info';
+        return a;
       });
+      view.has_attempts = view.attempts.length > 0;
+
+      var template = $('#dj_detail_template').html();
+      $('.modal').hide().remove();
+      $(Mustache.render(template, view)).appendTo($('body')).show();
+    }).fail(function(resp){
+      showMessage("warning", errorMessage(resp));
     });
+    return false;
+  });
 
-  })
+  // ---------------------------------------------------------------- dashboard
+
+  var chartWidth = 460, chartHeight = 160, chartPadding = 24;
+
+  // barChart 用 svg 画堆叠的柱状图， 不依赖外部的库， 离线也能使用
+  function barChart(buckets, series) {
+    var max = 1;
+    $.each(buckets, function(i, b){
+      var total = 0;
+      $.each(series, function(j, s){ total += b[s.key]; });
+      if (total > max) max = total;
+    });
+
+    var plotHeight = chartHeight - chartPadding;
+    var step = (chartWidth - chartPadding) / Math.max(buckets.length, 1);
+    var barWidth = Math.max(step - 2, 1);
+    var svg = ["<svg xmlns='http://www.w3.org/2000/svg' width='" + chartWidth + "' height='" + chartHeight + "'>"];
+
+    svg.push("<line x1='" + chartPadding + "' y1='" + plotHeight + "' x2='" + chartWidth + "' y2='" + plotHeight + "' class='axis' />");
+    svg.push("<text x='" + (chartPadding - 4) + "' y='10' class='tick' text-anchor='end'>" + max + "</text>");
+    svg.push("<text x='" + (chartPadding - 4) + "' y='" + plotHeight + "' class='tick' text-anchor='end'>0</text>");
+
+    $.each(buckets, function(i, b){
+      var x = chartPadding + i * step + 1;
+      var y = plotHeight;
+      var title = new Date(b.time).toLocaleString();
+      $.each(series, function(j, s){
+        var h = b[s.key] * plotHeight / max;
+        if (h <= 0) return;
+        y -= h;
+        svg.push("<rect x='" + x.toFixed(1) + "' y='" + y.toFixed(1) + "' width='" + barWidth.toFixed(1) + "' height='" + h.toFixed(1) +
+          "' class='" + s.key + "'><title>" + escapeHTML(title + ' ' + s.key + ': ' + b[s.key]) + "</title></rect>");
+      });
+    });
+
+    if (buckets.length > 0) {
+      svg.push("<text x='" + chartPadding + "' y='" + (chartHeight - 6) + "' class='tick'>" + escapeHTML(new Date(buckets[0].time).toLocaleString()) + "</text>");
+      svg.push("<text x='" + chartWidth + "' y='" + (chartHeight - 6) + "' class='tick' text-anchor='end'>now</text>");
+    }
+    svg.push("</svg>");
+
+    var legend = $.map(series, function(s){
+      return "<span class='legend " + s.key + "'></span> " + s.key;
+    }).join(' ');
+    return svg.join('') + "<div class='dj-legend'>" + legend + "</div>";
+  }
+
+  function queueHealth(queue) {
+    var recent = queue.recent || {};
+    var total = (recent.succeeded || 0) + (recent.retried || 0) + (recent.failed || 0);
+    if (queue.failed > 0 && (recent.failed || 0) > 0 && (recent.failed || 0) * 2 >= total)
+      return ['important', 'failing'];
+    if ((recent.failed || 0) > 0 || (recent.retried || 0) > 0)
+      return ['warning', 'degraded'];
+    return ['success', 'healthy'];
+  }
+
+  function loadDashboard() {
+    var range = $('#dj-stats-range').val().split(':');
+    var params = {range: range[0], buckets: range[1]};
+    var queue = $.trim($('#dj-stats-queue').val());
+    if (queue) params.queue = queue;
+
+    $.getJSON('api/v2/stats', params).success(function(buckets){
+      $('#dj-throughput-chart').html(barChart(buckets, [{key: 'succeeded'}]));
+      $('#dj-failure-chart').html(barChart(buckets, [{key: 'failed'}, {key: 'retried'}]));
+    }).error(function(resp){
+      $('#dj-throughput-chart, #dj-failure-chart').html("<div class='alert'>" + escapeHTML(errorMessage(resp)) + "</div>");
+    });
 
-  $('.nav.nav-tabs li.active a[data-toggle="tab"]').trigger('shown');
+    $.getJSON('api/v2/queues').success(function(queues){
+      $.each(queues, function(i, q){
+        var health = queueHealth(q);
+        q.health = health[0];
+        q.health_text = health[1];
+        q.display_name = q.name || '(default)';
+      });
+      if (queues.length > 0) {
+        $('#dj-queues-view').html(Mustache.render($('#dj_queues_template').html(), queues));
+      } else {
+        $('#dj-queues-view').html("<div class='alert centered'>No Queues</div>");
+      }
+    });
+  }
+
+  $('#dj-stats-refresh').click(function(){
+    loadDashboard();
+    return false;
+  });
+  $('#dj-stats-range').change(loadDashboard);
+
+  $('.queue-action').live('click', function(){
+    var queue = $(this).data('queue');
+    var action = $(this).data('action');
+    if (action == 'pause' && !confirm('Are you sure to pause the queue ' + queue + '?'))
+      return false;
+
+    $.ajax({
+      url: 'api/v2/queues/' + encodeURIComponent(queue) + '/' + action,
+      type: 'post',
+      complete: function(resp){
+        if (resp.status != 200) {
+          showMessage("warning", errorMessage(resp));
+        } else {
+          showMessage("success", "The queue " + queue + (action == 'pause' ? " was paused" : " was resumed"));
+        }
+        loadDashboard();
+      }
+    });
+    return false;
+  });
+
+  // 点击队列名时在 All 中列出该队列的任务
+  $('.queue-jobs').live('click', function(){
+    $('#all').data('queue', $(this).data('queue'));
+    $('a[href="#all"]').tab('show');
+    return false;
+  });
+
+  reloadActiveTab();
+
+  // ---------------------------------------------------------------- common
 
   $('a[rel=popover]').live('mouseenter', function(){
     $(this).popover('show');
@@ -65,13 +394,40 @@ $(function(){
     $('.modal').hide().remove();
   });
 
-  (function refreshCount() {
+  $('[data-dismiss="alert"]').live('click', function(){
+    $('.alert').hide().remove();
+  });
+
+  // 浏览器支持 EventSource 时由 /events 推送的任务事件触发刷新， 轮询只作为兜底
+  var refreshInterval = window.EventSource ? 60000 : 5000;
+  var refreshTimer = null;
+
+  function refreshCount() {
+    clearTimeout(refreshTimer);
     $.getJSON(dj_counts_dj_reports_url).success(function(data){
       var template = $('#dj_counts_template').html();
       var output = Mustache.render(template, data);
       $('#dj-counts-view').html(output);
-      setTimeout(refreshCount, 5000);
+    }).complete(function(){
+      refreshTimer = setTimeout(refreshCount, refreshInterval);
+    });
+  }
+  refreshCount();
+
+  if (window.EventSource) {
+    var pending = null;
+    var source = new EventSource('events');
+    $.each(['created', 'reserved', 'succeeded', 'failed', 'retried', 'deleted'], function(i, name){
+      source.addEventListener(name, function(){
+        // 合并短时间内的多个事件， 避免频繁查询数据库
+        if (pending) return;
+        pending = setTimeout(function(){
+          pending = null;
+          refreshCount();
+          if ($('#dashboard').hasClass('active')) loadDashboard();
+        }, 1000);
+      });
     });
-  })();
+  }
 
-})
\ No newline at end of file
+})
@@ -15,7 +15,7 @@ import (
 func TestPush(t *testing.T) {
 	backendTest(t, func(backend *dbBackend) {
 
-		srv := httptest.NewServer(&webFront{nil, backend})
+		srv := httptest.NewServer(&webFront{dbBackend: backend})
 		defer srv.Close()
 
 		var buffer bytes.Buffer
@@ -118,3 +118,69 @@ func TestPush(t *testing.T) {
 		}
 	})
 }
+
+func TestPushWithIdempotencyKey(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		srv := httptest.NewServer(&webFront{dbBackend: backend})
+		defer srv.Close()
+
+		key := "key-" + generate_id()
+		push := func(body string) (*http.Response, *createdJob) {
+			req, e := http.NewRequest("POST", srv.URL+"/push", strings.NewReader(body))
+			if nil != e {
+				t.Error(e)
+				return nil, nil
+			}
+			req.Header.Set("Idempotency-Key", key)
+			resp, e := http.DefaultClient.Do(req)
+			if nil != e {
+				t.Error(e)
+				return nil, nil
+			}
+			defer resp.Body.Close()
+			if http.StatusOK != resp.StatusCode {
+				return resp, nil
+			}
+
+			var created createdJob
+			if e := json.NewDecoder(resp.Body).Decode(&created); nil != e {
+				t.Error(e)
+				return nil, nil
+			}
+			return resp, &created
+		}
+
+		resp, first := push(`{"queue":"aa", "handler":{"type":"test"}}`)
+		if nil == first {
+			t.Error("push failed -", resp)
+			return
+		}
+		if 0 == first.Id || "" == first.HandlerId {
+			t.Error("id or handler_id is missing -", first)
+		}
+
+		resp, second := push(`{"queue":"aa", "handler":{"type":"test"}}`)
+		if nil == second {
+			t.Error("push failed -", resp)
+			return
+		}
+		if *first != *second {
+			t.Error("excepted", first, ", actual is", second)
+		}
+		if "true" != resp.Header.Get("Idempotent-Replayed") {
+			t.Error("Idempotent-Replayed is missing")
+		}
+
+		count, e := backend.count(map[string]interface{}{"@handler_id": first.HandlerId})
+		if nil != e {
+			t.Error(e)
+		} else if 1 != count {
+			t.Error("excepted count is 1, actual is", count)
+		}
+
+		resp, _ = push(`{"queue":"bb", "handler":{"type":"test"}}`)
+		if nil != resp && http.StatusUnprocessableEntity != resp.StatusCode {
+			t.Error("excepted status is 422, actual is", resp.StatusCode)
+		}
+	})
+}
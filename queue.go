@@ -0,0 +1,178 @@
+package delayed_job
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"time"
+)
+
+// Queue 用于在程序中直接创建任务， 不需要经过 http api， 例如
+//
+//	q, e := delayed_job.NewQueue("postgres", url)
+//	if nil != e {
+//		return e
+//	}
+//	defer q.Close()
+//	id, e := q.Enqueue(ctx, "mail", &Mail{To: "a@example.com"}, delayed_job.WithQueue("mail"))
+type Queue struct {
+	backend *dbBackend
+}
+
+// NewQueue 创建 Queue， 它不会启动 worker， 任务由其它进程执行
+func NewQueue(drv, dbURL string) (*Queue, error) {
+	initDB()
+
+	ctx := map[string]interface{}{}
+	backend, e := newBackend(drv, dbURL, ctx)
+	if nil != e {
+		return nil, e
+	}
+	ctx["backend"] = backend
+	return &Queue{backend: backend}, nil
+}
+
+// DB 返回 Queue 使用的数据库连接， 可以用它开始 EnqueueTx 的事务
+func (self *Queue) DB() *sql.DB {
+	return self.backend.db
+}
+
+// Close 关闭数据库连接
+func (self *Queue) Close() error {
+	return self.backend.Close()
+}
+
+type enqueueOptions struct {
+	priority     int
+	queue        string
+	run_at       time.Time
+	max_attempts int
+	unique_key   string
+}
+
+// EnqueueOption 是 Enqueue 的选项
+type EnqueueOption func(*enqueueOptions)
+
+// WithPriority 指定任务的优先级， 值越小越先执行， 默认为 default_priority 的值
+func WithPriority(priority int) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.priority = priority
+	}
+}
+
+// WithQueue 指定任务的队列， 默认为 default_queue_name 的值
+func WithQueue(queue string) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.queue = queue
+	}
+}
+
+// WithRunAt 指定任务的执行时间， 默认为立即执行
+func WithRunAt(run_at time.Time) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.run_at = run_at
+	}
+}
+
+// WithMaxAttempts 指定任务的最大尝试次数， 默认为 max_attempts 的值
+func WithMaxAttempts(max_attempts int) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.max_attempts = max_attempts
+	}
+}
+
+// WithUniqueKey 指定任务的 handler_id， 已存在相同 handler_id 的任务时会替换它， 而不是再创建一个
+func WithUniqueKey(key string) EnqueueOption {
+	return func(o *enqueueOptions) {
+		o.unique_key = key
+	}
+}
+
+// payloadToMap 将 payload 转换为 handler 的参数， payload 可以是 map 或可以序列化为 json 对象的值
+func payloadToMap(payload interface{}) (map[string]interface{}, error) {
+	if nil == payload {
+		return map[string]interface{}{}, nil
+	}
+	if m, ok := payload.(map[string]interface{}); ok {
+		params := make(map[string]interface{}, len(m)+1)
+		for k, v := range m {
+			params[k] = v
+		}
+		return params, nil
+	}
+
+	bs, e := json.Marshal(payload)
+	if nil != e {
+		return nil, errors.New("marshal payload failed, " + e.Error())
+	}
+	decoder := json.NewDecoder(bytes.NewReader(bs))
+	decoder.UseNumber()
+	var params map[string]interface{}
+	if e := decoder.Decode(&params); nil != e {
+		return nil, errors.New("payload must is an object, " + e.Error())
+	}
+	if nil == params {
+		return nil, errors.New("payload must is an object")
+	}
+	return params, nil
+}
+
+// newJob 按 Enqueue 的参数创建任务， 生成的 handler 和 api 创建的相同
+func (self *Queue) newJob(ctx context.Context, handlerType string, payload interface{}, options []EnqueueOption) (*Job, error) {
+	if "" == handlerType {
+		return nil, errors.New("handler type is required")
+	}
+	if _, ok := Handlers[handlerType]; !ok {
+		return nil, errors.New("'" + handlerType + "' is unsupported handler")
+	}
+
+	o := enqueueOptions{priority: *default_priority, queue: *default_queue_name}
+	for _, option := range options {
+		option(&o)
+	}
+
+	handler, e := payloadToMap(payload)
+	if nil != e {
+		return nil, e
+	}
+	handler["type"] = handlerType
+	if "" != o.unique_key {
+		handler["_uid"] = o.unique_key
+	}
+	injectTraceContext(ctx, handler)
+
+	if e := validateHandler(handler); nil != e {
+		return nil, e
+	}
+	return newJob(self.backend, o.priority, 0, "", o.max_attempts, o.queue, o.run_at, handler, true)
+}
+
+// Enqueue 创建一个任务并返回它的 id， handlerType 是注册在 Handlers 中的名称
+func (self *Queue) Enqueue(ctx context.Context, handlerType string, payload interface{}, options ...EnqueueOption) (int64, error) {
+	job, e := self.newJob(ctx, handlerType, payload, options)
+	if nil != e {
+		return 0, e
+	}
+	if e := self.backend.create(job); nil != e {
+		return 0, e
+	}
+	return job.id, nil
+}
+
+// EnqueueTx 在调用者的事务中创建任务， 只有事务提交后任务才可见， 回滚时任务也不会创建，
+// tx 必须是 Queue 所连接的数据库上的事务， 这种方式创建的任务不会发布 created 事件和计入 metrics
+func (self *Queue) EnqueueTx(ctx context.Context, tx *sql.Tx, handlerType string, payload interface{}, options ...EnqueueOption) (int64, error) {
+	if nil == tx {
+		return 0, errors.New("tx is nil")
+	}
+	job, e := self.newJob(ctx, handlerType, payload, options)
+	if nil != e {
+		return 0, e
+	}
+	if e := self.backend.insertJob(tx, job, self.backend.db_time_now()); nil != e {
+		return 0, e
+	}
+	return job.id, nil
+}
@@ -0,0 +1,168 @@
+package delayed_job
+
+import (
+	"errors"
+	"flag"
+	"strings"
+	"time"
+)
+
+var (
+	paused_queues_table  = flag.String("paused_queues.db_table", "", "the table name for the paused queues, default is the job table name with suffix '_paused_queues'")
+	paused_queues_reload = flag.Duration("paused_queues.reload", 5*time.Second, "the interval of the worker reloads the paused queues")
+)
+
+func pausedQueuesTableName() string {
+	if "" != *paused_queues_table {
+		return *paused_queues_table
+	}
+	return *table_name + "_paused_queues"
+}
+
+// pausedQueuesTableScripts 返回创建暂停队列表的语句， 表已存在时不会删除它
+func pausedQueuesTableScripts(dbType int) []string {
+	name := pausedQueuesTableName()
+	switch dbType {
+	case MSSQL:
+		return []string{`if object_id('dbo.` + name + `', 'U') is null
+				BEGIN
+				 CREATE TABLE dbo.` + name + ` (
+						  queue             varchar(200) PRIMARY KEY,
+						  paused_by         varchar(200),
+						  paused_at         DATETIME2 NOT NULL
+						);
+				END`}
+	case POSTGRESQL, KINGBASE, OPENGAUSS, GAUSSDB:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+				  queue             varchar(200) PRIMARY KEY,
+				  paused_by         varchar(200),
+				  paused_at         timestamp with time zone NOT NULL
+				);`}
+	case ORACLE:
+		return []string{`BEGIN
+   EXECUTE IMMEDIATE 'CREATE TABLE ` + name + ` (
+					  queue             varchar2(200 BYTE) PRIMARY KEY,
+					  paused_by         varchar2(200 BYTE),
+					  paused_at         timestamp with time zone NOT NULL
+					)';
+EXCEPTION
+   WHEN OTHERS THEN
+      IF SQLCODE != -955 THEN
+         RAISE;
+      END IF;
+END;`}
+	case DM:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  queue             varchar2(200 BYTE) PRIMARY KEY,
+					  paused_by         varchar2(200 BYTE),
+					  paused_at         timestamp with time zone NOT NULL
+					)`}
+	default:
+		return []string{`CREATE TABLE IF NOT EXISTS ` + name + ` (
+					  queue             varchar(200) PRIMARY KEY,
+					  paused_by         varchar(200),
+					  paused_at         DATETIME NOT NULL
+					);`}
+	}
+}
+
+func (self *dbBackend) initPausedQueuesTable() error {
+	for _, script := range pausedQueuesTableScripts(self.dbType) {
+		if _, e := self.db.Exec(script); nil != e {
+			return i18n(self.dbType, self.drv, e)
+		}
+	}
+	return nil
+}
+
+// pausedQueues 返回被暂停的队列
+func (self *dbBackend) pausedQueues() (map[string]bool, error) {
+	rows, e := self.db.Query("SELECT queue FROM " + pausedQueuesTableName())
+	if nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	defer rows.Close()
+
+	results := map[string]bool{}
+	for rows.Next() {
+		var queue string
+		if e = rows.Scan(&queue); nil != e {
+			return nil, i18n(self.dbType, self.drv, e)
+		}
+		results[queue] = true
+	}
+	if e = rows.Err(); nil != e {
+		return nil, i18n(self.dbType, self.drv, e)
+	}
+	return results, nil
+}
+
+// pauseQueue 暂停队列， 已经在运行的任务不受影响， 返回 false 表示队列本来就已暂停
+func (self *dbBackend) pauseQueue(queue, actor string) (bool, error) {
+	if "" == queue {
+		return false, errors.New("queue name is required")
+	}
+	paused, e := self.pausedQueues()
+	if nil != e {
+		return false, e
+	}
+	if paused[queue] {
+		return false, nil
+	}
+
+	ss := placeholders(self.dbType, 1, 3)
+	_, e = self.db.Exec("INSERT INTO "+pausedQueuesTableName()+"(queue, paused_by, paused_at) VALUES("+ss[0]+", "+ss[1]+", "+ss[2]+")",
+		queue, actor, self.db_time_now())
+	if nil != e {
+		return false, i18n(self.dbType, self.drv, e)
+	}
+	return true, nil
+}
+
+// resumeQueue 恢复队列， 返回 false 表示队列本来就没有暂停
+func (self *dbBackend) resumeQueue(queue string) (bool, error) {
+	result, e := self.db.Exec("DELETE FROM "+pausedQueuesTableName()+" WHERE queue = "+placeholders(self.dbType, 1, 1)[0], queue)
+	if nil != e {
+		return false, i18n(self.dbType, self.drv, e)
+	}
+	n, e := result.RowsAffected()
+	if nil != e {
+		return false, i18n(self.dbType, self.drv, e)
+	}
+	return n > 0, nil
+}
+
+// pausedQueuesCondition 生成 reserve 时排除暂停队列的条件， 队列名作为参数传入， start 是第一个参数的序号
+func pausedQueuesCondition(dbType, start int, paused []string) (string, []interface{}) {
+	if 0 == len(paused) {
+		return "", nil
+	}
+	args := make([]interface{}, len(paused))
+	for i, s := range paused {
+		args[i] = s
+	}
+	return " AND (queue IS NULL OR queue NOT IN (" + strings.Join(placeholders(dbType, start, len(paused)), ", ") + "))", args
+}
+
+// pausedQueues 返回缓存的暂停队列， 每隔 paused_queues.reload 重新读取一次， 读取失败时沿用上一次的值
+func (self *worker) pausedQueues(backend *dbBackend) []string {
+	if !self.paused_loaded_at.IsZero() && time.Now().Sub(self.paused_loaded_at) < *paused_queues_reload {
+		return self.paused
+	}
+	self.paused_loaded_at = time.Now()
+
+	paused, e := backend.pausedQueues()
+	if nil != e {
+		if !self.paused_warned {
+			self.paused_warned = true
+			self.logger().Warn("load paused queues failed, run '-mode migrate' to create the table", "error", e)
+		}
+		return self.paused
+	}
+
+	self.paused = self.paused[:0]
+	for queue := range paused {
+		self.paused = append(self.paused, queue)
+	}
+	return self.paused
+}
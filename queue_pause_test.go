@@ -0,0 +1,74 @@
+package delayed_job
+
+import (
+	"testing"
+	"time"
+)
+
+func TestPausedQueuesCondition(t *testing.T) {
+	if s, args := pausedQueuesCondition(POSTGRESQL, 6, nil); "" != s || 0 != len(args) {
+		t.Error("excepted empty, actual is", s, args)
+	}
+	s, args := pausedQueuesCondition(POSTGRESQL, 6, []string{"aa", `\' ) OR 1=1 -- `})
+	if " AND (queue IS NULL OR queue NOT IN ($6, $7))" != s {
+		t.Error("actual is", s)
+	}
+	if 2 != len(args) || "aa" != args[0] || `\' ) OR 1=1 -- ` != args[1] {
+		t.Error("arguments is invalid -", args)
+	}
+	if s, _ := pausedQueuesCondition(MYSQL, 1, []string{"a\\"}); " AND (queue IS NULL OR queue NOT IN (?))" != s {
+		t.Error("actual is", s)
+	}
+}
+
+func TestPauseQueue(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		defer backend.resumeQueue("aa")
+
+		if _, e := backend.pauseQueue("", "test"); nil == e {
+			t.Error("excepted error for empty queue")
+		}
+
+		e := backend.enqueue(1, 0, "", 1, "aa", time.Time{}, map[string]interface{}{"type": "test"})
+		if nil != e {
+			t.Error(e)
+			return
+		}
+
+		if changed, e := backend.pauseQueue("aa", "test"); nil != e {
+			t.Error(e)
+			return
+		} else if !changed {
+			t.Error("excepted queue is paused")
+		}
+		if changed, e := backend.pauseQueue("aa", "test"); nil != e || changed {
+			t.Error("excepted queue is already paused -", changed, e)
+		}
+
+		w := &worker{min_priority: -1, max_priority: -1, name: "aa_pid:123", max_run_time: 1 * time.Minute}
+		job, e := backend.reserve(w)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if nil != job {
+			t.Error("excepted job of paused queue isn't reserved, actual is", job.id)
+			return
+		}
+
+		if changed, e := backend.resumeQueue("aa"); nil != e || !changed {
+			t.Error("excepted queue is resumed -", changed, e)
+			return
+		}
+
+		w = &worker{min_priority: -1, max_priority: -1, name: "aa_pid:123", max_run_time: 1 * time.Minute}
+		job, e = backend.reserve(w)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if nil == job {
+			t.Error("excepted job is reserved after the queue is resumed")
+		}
+	})
+}
@@ -0,0 +1,208 @@
+package delayed_job
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+)
+
+type typedTestPayload struct {
+	Name  string   `json:"name"`
+	Count int      `json:"count"`
+	Tags  []string `json:"tags,omitempty"`
+}
+
+func TestPayloadToMap(t *testing.T) {
+	params, e := payloadToMap(&typedTestPayload{Name: "a", Count: 2})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if "a" != params["name"] || json.Number("2") != params["count"] {
+		t.Error("actual is", params)
+	}
+	if _, ok := params["tags"]; ok {
+		t.Error("excepted tags is omitted, actual is", params)
+	}
+
+	m := map[string]interface{}{"a": "b"}
+	params, e = payloadToMap(m)
+	if nil != e {
+		t.Fatal(e)
+	}
+	params["type"] = "test"
+	if _, ok := m["type"]; ok {
+		t.Error("excepted payload isn't changed")
+	}
+
+	if params, e = payloadToMap(nil); nil != e || 0 != len(params) {
+		t.Error("actual is", params, e)
+	}
+	if _, e = payloadToMap([]string{"a"}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestRegisterHandler(t *testing.T) {
+	var actual typedTestPayload
+	RegisterHandler("typed_test", func(ctx context.Context, payload typedTestPayload) error {
+		actual = payload
+		if "fail" == payload.Name {
+			return errors.New("failed")
+		}
+		return nil
+	})
+	defer delete(Handlers, "typed_test")
+
+	h, e := newHandler(nil, map[string]interface{}{"type": "typed_test", "name": "a", "count": float64(3), "tags": []interface{}{"x"}})
+	if nil != e {
+		t.Fatal(e)
+	}
+	performer, ok := h.(ContextHandler)
+	if !ok {
+		t.Fatalf("excepted is ContextHandler, actual is %T", h)
+	}
+	if e := performer.PerformContext(context.Background()); nil != e {
+		t.Error(e)
+	}
+	if "a" != actual.Name || 3 != actual.Count || 1 != len(actual.Tags) {
+		t.Error("actual is", actual)
+	}
+
+	h, e = newHandler(nil, map[string]interface{}{"type": "typed_test", "name": "fail"})
+	if nil != e {
+		t.Fatal(e)
+	}
+	if e := h.Perform(); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+
+	if _, e = newHandler(nil, map[string]interface{}{"type": "typed_test", "count": "abc"}); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestQueueEnqueue(t *testing.T) {
+	RegisterHandler("typed_test", func(ctx context.Context, payload typedTestPayload) error {
+		return nil
+	})
+	defer delete(Handlers, "typed_test")
+
+	backendTest(t, func(backend *dbBackend) {
+		q, e := NewQueue(GetTestConnDrv(), GetTestConnURL())
+		if nil != e {
+			t.Fatal(e)
+		}
+		defer q.Close()
+
+		ctx := context.Background()
+		run_at := time.Now().Add(time.Hour).Truncate(time.Second)
+		id, e := q.Enqueue(ctx, "typed_test", &typedTestPayload{Name: "a", Count: 2},
+			WithPriority(3), WithQueue("typed"), WithRunAt(run_at), WithMaxAttempts(4), WithUniqueKey("typed_1"))
+		if nil != e {
+			t.Fatal(e)
+		}
+
+		jobs, e := backend.where(map[string]interface{}{"@id": id})
+		if nil != e {
+			t.Fatal(e)
+		}
+		if 1 != len(jobs) {
+			t.Fatal("excepted 1 job, actual is", len(jobs))
+		}
+		job := jobs[0]
+		if 3 != job["priority"] || "typed" != job["queue"] || 4 != job["max_attempts"] || "typed_1" != job["handler_id"] {
+			t.Error("actual is", job)
+		}
+		if at, _ := job["run_at"].(time.Time); !at.Equal(run_at) {
+			t.Error("excepted run_at is", run_at, ", actual is", job["run_at"])
+		}
+
+		var handler map[string]interface{}
+		if e := json.Unmarshal([]byte(job["handler"].(string)), &handler); nil != e {
+			t.Fatal(e)
+		}
+		if "typed_test" != handler["type"] || "a" != handler["name"] || float64(2) != handler["count"] {
+			t.Error("actual handler is", handler)
+		}
+
+		// 相同的 unique key 会替换原来的任务
+		if _, e = q.Enqueue(ctx, "typed_test", map[string]interface{}{"name": "b"}, WithUniqueKey("typed_1")); nil != e {
+			t.Fatal(e)
+		}
+		count, e := backend.count(map[string]interface{}{"@handler_id": "typed_1"})
+		if nil != e {
+			t.Fatal(e)
+		}
+		if 1 != count {
+			t.Error("excepted 1 job, actual is", count)
+		}
+
+		if _, e = q.Enqueue(ctx, "not_exists", nil); nil == e {
+			t.Error("excepted error, actual is nil")
+		}
+	})
+}
+
+func TestQueueEnqueueTx(t *testing.T) {
+	backendTest(t, func(backend *dbBackend) {
+		q, e := NewQueue(GetTestConnDrv(), GetTestConnURL())
+		if nil != e {
+			t.Fatal(e)
+		}
+		defer q.Close()
+
+		countJobs := func() int64 {
+			count, e := backend.count(map[string]interface{}{"@handler_id": "tx_1"})
+			if nil != e {
+				t.Fatal(e)
+			}
+			return count
+		}
+
+		ctx := context.Background()
+		tx, e := q.DB().Begin()
+		if nil != e {
+			t.Fatal(e)
+		}
+		if _, e = q.EnqueueTx(ctx, tx, "test", map[string]interface{}{"a": "b"}, WithUniqueKey("tx_1")); nil != e {
+			tx.Rollback()
+			t.Fatal(e)
+		}
+		if e = tx.Rollback(); nil != e {
+			t.Fatal(e)
+		}
+		if count := countJobs(); 0 != count {
+			t.Error("excepted 0 job after rollback, actual is", count)
+		}
+
+		tx, e = q.DB().Begin()
+		if nil != e {
+			t.Fatal(e)
+		}
+		id, e := q.EnqueueTx(ctx, tx, "test", map[string]interface{}{"a": "b"}, WithUniqueKey("tx_1"))
+		if nil != e {
+			tx.Rollback()
+			t.Fatal(e)
+		}
+		if e = tx.Commit(); nil != e {
+			t.Fatal(e)
+		}
+		if count := countJobs(); 1 != count {
+			t.Error("excepted 1 job after commit, actual is", count)
+		}
+
+		jobs, e := backend.where(map[string]interface{}{"@id": id})
+		if nil != e {
+			t.Fatal(e)
+		}
+		if 1 != len(jobs) || "tx_1" != jobs[0]["handler_id"] {
+			t.Error("actual is", jobs)
+		}
+
+		if _, e = q.EnqueueTx(ctx, nil, "test", nil); nil == e {
+			t.Error("excepted error, actual is nil")
+		}
+	})
+}
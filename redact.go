@@ -0,0 +1,135 @@
+package delayed_job
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"path"
+	"regexp"
+	"strings"
+)
+
+const redacted_text = "******"
+
+var (
+	redact_fields = flag.String("redact.fields", "", "the extra names(or patterns, such as '*key*') of the secret fields which are masked in the logs and the web ui, split by ','")
+
+	default_redact_patterns = []string{"*password*", "*passwd*", "*secret*", "*token*", "authorization"}
+
+	// 连接字符串中的密码， 如 mysql 的 user:pass@tcp(127.0.0.1:3306)/db 和 mssql 的 password=pass;
+	url_password_pattern  = regexp.MustCompile(`(://[^:/@\s]*:)[^@\s]*@`)
+	dsn_password_pattern  = regexp.MustCompile(`^([^:/@\s]+:)[^@/\s]*@`)
+	pair_password_pattern = regexp.MustCompile(`(?i)((?:password|pwd)\s*=\s*)[^;\s]*`)
+)
+
+func redactPatterns() []string {
+	if "" == strings.TrimSpace(*redact_fields) {
+		return default_redact_patterns
+	}
+	patterns := append([]string{}, default_redact_patterns...)
+	for _, s := range strings.Split(*redact_fields, ",") {
+		if s = strings.TrimSpace(s); "" != s {
+			patterns = append(patterns, strings.ToLower(s))
+		}
+	}
+	return patterns
+}
+
+// isSecretField 判断字段名是否是一个需要屏蔽的字段
+func isSecretField(name string) bool {
+	name = strings.ToLower(name)
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		name = name[idx+1:]
+	}
+	for _, pattern := range redactPatterns() {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// redactText 屏蔽文本中的连接字符串中的密码
+func redactText(s string) string {
+	if "" == s {
+		return s
+	}
+	s = url_password_pattern.ReplaceAllString(s, "${1}"+redacted_text+"@")
+	s = dsn_password_pattern.ReplaceAllString(s, "${1}"+redacted_text+"@")
+	return pair_password_pattern.ReplaceAllString(s, "${1}"+redacted_text)
+}
+
+// redactValue 返回一个屏蔽了密码等字段的副本， 原来的值不会被修改
+func redactValue(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		return redactMap(v)
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		for i := range v {
+			copied[i] = redactValue(v[i])
+		}
+		return copied
+	case string:
+		return redactText(v)
+	default:
+		return value
+	}
+}
+
+func redactMap(m map[string]interface{}) map[string]interface{} {
+	if nil == m {
+		return nil
+	}
+	copied := make(map[string]interface{}, len(m))
+	for k, v := range m {
+		if isSecretField(k) {
+			if nil == v || "" == v {
+				copied[k] = v
+			} else {
+				copied[k] = redacted_text
+			}
+			continue
+		}
+		copied[k] = redactValue(v)
+	}
+	return copied
+}
+
+// redactHandler 屏蔽任务的 handler 中的密码等字段
+func redactHandler(handler string) string {
+	decoder := json.NewDecoder(strings.NewReader(handler))
+	decoder.UseNumber()
+	var attributes map[string]interface{}
+	if e := decoder.Decode(&attributes); nil != e {
+		return redactText(handler)
+	}
+
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	if e := encoder.Encode(redactMap(attributes)); nil != e {
+		return redactText(handler)
+	}
+	return strings.TrimSpace(buffer.String())
+}
+
+// unredactMap 将 redacted 中未被修改的屏蔽值还原为 original 中的值， 用于保存从 web ui 提交的数据
+func unredactMap(redacted, original map[string]interface{}) {
+	for k, v := range redacted {
+		switch value := v.(type) {
+		case map[string]interface{}:
+			if o, ok := original[k].(map[string]interface{}); ok {
+				unredactMap(value, o)
+			}
+		case string:
+			o, ok := original[k].(string)
+			if !ok || value == o {
+				continue
+			}
+			if (isSecretField(k) && redacted_text == value) || value == redactText(o) {
+				redacted[k] = o
+			}
+		}
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"expvar"
 	"flag"
 	"fmt"
-	"log"
 	"runtime"
 	"strings"
 	"sync"
@@ -27,13 +26,16 @@ type redis_request struct {
 }
 
 type redis_gateway struct {
-	Address   string
-	Password  string
-	client    *redis.Client
-	ctx       context.Context
-	c         chan *redis_request
-	is_closed int32
-	wait      sync.WaitGroup
+	mu       sync.Mutex
+	Address  string
+	Password string
+	client   *redis.Client
+	ctx      context.Context
+	c        chan *redis_request
+	// reconnect_c 通知 serve 使用新的地址重连， 它只有一个位置， 已有通知时不用再发
+	reconnect_c chan struct{}
+	is_closed   int32
+	wait        sync.WaitGroup
 }
 
 func (self *redis_gateway) isRunning() bool {
@@ -59,11 +61,24 @@ func (self *redis_gateway) Call(commands [][]string) error {
 	return <-c
 }
 
+// reconnect 使用新的地址和密码重新连接， 在执行完当前的命令后生效， 它不会阻塞
+func (self *redis_gateway) reconnect(address, password string) {
+	self.mu.Lock()
+	self.Address = address
+	self.Password = password
+	self.mu.Unlock()
+
+	select {
+	case self.reconnect_c <- struct{}{}:
+	default:
+	}
+}
+
 func (self *redis_gateway) serve() {
 	defer func() {
 		atomic.StoreInt32(&self.is_closed, 1)
 		self.wait.Done()
-		log.Println("redis client is exit.")
+		logger.Info("redis client is exited")
 	}()
 
 	error_count := uint(0)
@@ -89,15 +104,25 @@ func (self *redis_gateway) runOnce(error_count *uint) {
 			}
 			msg := buffer.String()
 			redis_error.Set(msg)
-			log.Println(msg)
+			logger.Error("redis client is panic", "error", fmt.Sprint(e), "stack", msg)
 		}
 	}()
 
 	self.ctx = context.Background()
 
+	// 下面会读取最新的地址， 之前的重连通知已经不需要了
+	select {
+	case <-self.reconnect_c:
+	default:
+	}
+
+	self.mu.Lock()
+	address, password := self.Address, self.Password
+	self.mu.Unlock()
+
 	rdb := redis.NewClient(&redis.Options{
-		Addr:         self.Address,
-		Password:     self.Password,
+		Addr:         address,
+		Password:     password,
 		DialTimeout:  1 * time.Second,
 		ReadTimeout:  1 * time.Second,
 		WriteTimeout: 1 * time.Second,
@@ -106,13 +131,13 @@ func (self *redis_gateway) runOnce(error_count *uint) {
 	status := rdb.Ping(self.ctx)
 	if status.Err() != nil {
 		err := status.Err()
-		msg := fmt.Sprintf("[redis] connect to '%s' failed, %v", self.Address, err)
+		msg := fmt.Sprintf("[redis] connect to '%s' failed, %v", address, err)
 		redis_error.Set(msg)
 		*error_count++
 		if *error_count < 5 {
-			log.Println(msg)
+			logger.Warn("connect to redis failed", "address", address, "error", err)
 		} else if 0 == (*error_count % 10) {
-			log.Println(msg)
+			logger.Warn("connect to redis failed", "address", address, "error", err, "count", *error_count)
 
 			for {
 				select {
@@ -140,7 +165,15 @@ func (self *redis_gateway) runOnce(error_count *uint) {
 	self.client = rdb
 
 	for self.isRunning() {
-		req, ok := <-self.c
+		var req *redis_request
+		var ok bool
+		select {
+		case <-self.reconnect_c:
+			logger.Info("reconnect to redis", "old_address", address)
+			rdb.Close()
+			return
+		case req, ok = <-self.c:
+		}
 		if !ok {
 			break
 		}
@@ -160,7 +193,7 @@ func (self *redis_gateway) runOnce(error_count *uint) {
 		}
 		if nil != e {
 			redis_error.Set(e.Error())
-			fmt.Println("[redis_gateway]", e)
+			logger.Warn("execute redis commands failed", "address", address, "error", e)
 			break
 		}
 	}
@@ -211,7 +244,8 @@ func (self *redis_gateway) redis_do(cmd []string) error {
 }
 
 func newRedis(address, password string) (*redis_gateway, error) {
-	client := &redis_gateway{Address: address, Password: password, c: make(chan *redis_request, 3000)}
+	client := &redis_gateway{Address: address, Password: password, c: make(chan *redis_request, 3000),
+		reconnect_c: make(chan struct{}, 1)}
 	go client.serve()
 	client.wait.Add(1)
 	return client, nil
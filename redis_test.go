@@ -266,3 +266,20 @@ func TestRedisConnectFailed(t *testing.T) {
 	}
 	t.Log(e)
 }
+
+func TestRedisReconnectWhenQueueIsFull(t *testing.T) {
+	redis_client := &redis_gateway{Address: "127.0.0.1:3",
+		c:           make(chan *redis_request, 1),
+		reconnect_c: make(chan struct{}, 1)}
+	redis_client.c <- &redis_request{commands: [][]string{{"SET", "a1", "1223"}}}
+
+	// 队列已满时也不会阻塞， 也不会丢失重连的通知
+	redis_client.reconnect("127.0.0.1:4", "")
+	redis_client.reconnect("127.0.0.1:5", "abc")
+	if 1 != len(redis_client.reconnect_c) {
+		t.Error("reconnect isn't notified")
+	}
+	if "127.0.0.1:5" != redis_client.Address || "abc" != redis_client.Password {
+		t.Error("actual is", redis_client.Address, redis_client.Password)
+	}
+}
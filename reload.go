@@ -0,0 +1,432 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/http"
+	"os"
+	"os/signal"
+	"path"
+	"reflect"
+	"sort"
+	"sync"
+	"syscall"
+	"time"
+)
+
+var (
+	config_watch_interval = flag.Duration("config.watch_interval", 5*time.Second, "the interval of checking whether the config file is changed, the config file is reloaded if it is changed, 0 is disabled")
+
+	// static_flags 是启动时才读取的配置， 修改后要重启才能生效
+	static_flags = []string{"delayed-config",
+		"config.watch_interval",
+		"db_table",
+		"*.db_table",
+		"http.*",
+		"auth.*",
+		"log.*",
+		"secrets.*",
+		"tracing.*",
+		"tracing.otlp.*",
+		"events.history",
+		"events.buffer",
+		"job_pid_file",
+		"name_prefix"}
+
+	// reload_mu 保证同时只有一次重新加载， config_mu 只保护下面的变量， 调用 listener 时不持有它
+	reload_mu        sync.Mutex
+	config_mu        sync.Mutex
+	config_listeners []*configListener
+	last_reload      *reloadResult
+
+	// config_values 是热加载后的配置， 热加载不修改 flag 本身， 以免与读取它的 goroutine 竞争，
+	// 可以热加载的配置要通过 configValue 读取
+	config_values = map[string]flag.Value{}
+)
+
+// configListener 是配置改变的通知， apply 返回错误时拒绝这次修改
+type configListener struct {
+	names []string
+	apply func(values map[string]interface{}) error
+}
+
+func (self *configListener) match(name string) bool {
+	for _, pattern := range self.names {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+// onConfigChanged 注册可以热加载的配置， names 是 flag 名， 可以使用 '*' 通配符， 没有注册的配置要重启才能生效，
+// 它们改变时调用 apply， values 是 names 匹配的所有 flag 的新值， apply 返回错误时拒绝这次修改，
+// apply 为 nil 表示使用者每次都通过 configValue 读取配置， 返回的函数用于取消注册
+func onConfigChanged(names []string, apply func(values map[string]interface{}) error) func() {
+	listener := &configListener{names: names, apply: apply}
+
+	config_mu.Lock()
+	config_listeners = append(config_listeners, listener)
+	config_mu.Unlock()
+
+	return func() {
+		config_mu.Lock()
+		defer config_mu.Unlock()
+		for i, l := range config_listeners {
+			if l == listener {
+				config_listeners = append(config_listeners[:i:i], config_listeners[i+1:]...)
+				break
+			}
+		}
+	}
+}
+
+func isHotFlag(name string) bool {
+	for _, listener := range config_listeners {
+		if listener.match(name) {
+			return true
+		}
+	}
+	return false
+}
+
+// newFlagValue 创建一个与 g 类型相同的值， 用于在不修改 flag 的情况下解析新的值
+func newFlagValue(g *flag.Flag) (flag.Value, bool) {
+	t := reflect.TypeOf(g.Value)
+	if reflect.Ptr != t.Kind() || reflect.Func == t.Elem().Kind() {
+		return nil, false
+	}
+	v, ok := reflect.New(t.Elem()).Interface().(flag.Value)
+	if !ok {
+		return nil, false
+	}
+	if _, ok := v.(flag.Getter); !ok {
+		return nil, false
+	}
+	return v, true
+}
+
+func currentFlagValue(g *flag.Flag) flag.Value {
+	if v, ok := config_values[g.Name]; ok {
+		return v
+	}
+	return g.Value
+}
+
+func getFlagValue(v flag.Value) interface{} {
+	if getter, ok := v.(flag.Getter); ok {
+		return getter.Get()
+	}
+	return v.String()
+}
+
+// configValue 返回配置的当前值， 包含热加载的修改， flag 不存在时返回 nil
+func configValue(name string) interface{} {
+	config_mu.Lock()
+	defer config_mu.Unlock()
+
+	g := flag.Lookup(name)
+	if nil == g {
+		return nil
+	}
+	return getFlagValue(currentFlagValue(g))
+}
+
+func configString(name string) string {
+	s, _ := configValue(name).(string)
+	return s
+}
+
+func configInt(name string) int {
+	i, _ := configValue(name).(int)
+	return i
+}
+
+func configBool(name string) bool {
+	b, _ := configValue(name).(bool)
+	return b
+}
+
+func configDuration(name string) time.Duration {
+	d, _ := configValue(name).(time.Duration)
+	return d
+}
+
+func isStaticFlag(name string) bool {
+	for _, pattern := range static_flags {
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+	}
+	return false
+}
+
+type rejectedSetting struct {
+	Name  string `json:"name"`
+	Error string `json:"error"`
+}
+
+// reloadResult 是重新加载配置的结果， Restart 中的配置要重启后才会生效， Unknown 中的配置被忽略
+type reloadResult struct {
+	Source     string            `json:"source"`
+	ReloadedAt time.Time         `json:"reloaded_at"`
+	Changed    []string          `json:"changed,omitempty"`
+	Restart    []string          `json:"restart_required,omitempty"`
+	Unknown    []string          `json:"unknown,omitempty"`
+	Rejected   []rejectedSetting `json:"rejected,omitempty"`
+}
+
+func (self *reloadResult) reject(name string, e error) {
+	self.Rejected = append(self.Rejected, rejectedSetting{Name: name, Error: e.Error()})
+}
+
+type configChange struct {
+	flag  *flag.Flag
+	value flag.Value
+}
+
+// settingChanges 检查配置并返回改变了的可以热加载的配置， 它不修改任何配置
+func settingChanges(source string, settings map[string]interface{}) (*reloadResult, []*configChange, error) {
+	values := map[string]string{}
+	if e := flattenSettings("", settings, values); nil != e {
+		return nil, nil, e
+	}
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	config_mu.Lock()
+	defer config_mu.Unlock()
+
+	result := &reloadResult{Source: source, ReloadedAt: time.Now()}
+	var changes []*configChange
+	for _, name := range names {
+		if _, ok := default_actuals[name]; ok {
+			continue
+		}
+		g := flag.Lookup(name)
+		if nil == g {
+			result.Unknown = append(result.Unknown, name)
+			continue
+		}
+
+		// 在一个新的值中检查是否有效， 同时得到规范化后的值
+		old := currentFlagValue(g).String()
+		value, ok := newFlagValue(g)
+		if !ok {
+			if values[name] != old {
+				result.Restart = append(result.Restart, name)
+			}
+			continue
+		}
+		if e := value.Set(values[name]); nil != e {
+			result.reject(name, e)
+			continue
+		}
+		if value.String() == old {
+			continue
+		}
+		if isStaticFlag(name) || !isHotFlag(name) {
+			result.Restart = append(result.Restart, name)
+			continue
+		}
+		changes = append(changes, &configChange{flag: g, value: value})
+	}
+	return result, changes, nil
+}
+
+// checkSettings 检查配置是否有效， 它不修改任何配置， 也不通知 listener
+func checkSettings(source string, settings map[string]interface{}) (*reloadResult, error) {
+	result, _, e := settingChanges(source, settings)
+	return result, e
+}
+
+// applySettings 将配置保存到 config_values 中并通知关心它们的组件， 命令行中指定的 flag 不会被修改，
+// atomic 为 true 时只要有一个配置是无效的就不修改任何配置
+func applySettings(source string, settings map[string]interface{}, atomic bool) (*reloadResult, error) {
+	reload_mu.Lock()
+	defer reload_mu.Unlock()
+
+	result, changes, e := settingChanges(source, settings)
+	if nil != e {
+		return nil, e
+	}
+	config_mu.Lock()
+	listeners := append([]*configListener(nil), config_listeners...)
+	config_mu.Unlock()
+
+	if atomic && 0 != len(result.Rejected) {
+		return result, nil
+	}
+
+	rejected := map[string]bool{}
+	for _, listener := range listeners {
+		if nil == listener.apply {
+			continue
+		}
+		var matched []*configChange
+		for _, change := range changes {
+			if !rejected[change.flag.Name] && listener.match(change.flag.Name) {
+				matched = append(matched, change)
+			}
+		}
+		if 0 == len(matched) {
+			continue
+		}
+
+		snapshot := map[string]interface{}{}
+		config_mu.Lock()
+		flag.VisitAll(func(g *flag.Flag) {
+			if listener.match(g.Name) {
+				snapshot[g.Name] = getFlagValue(currentFlagValue(g))
+			}
+		})
+		config_mu.Unlock()
+		for _, change := range matched {
+			snapshot[change.flag.Name] = getFlagValue(change.value)
+		}
+		if e := listener.apply(snapshot); nil != e {
+			for _, change := range matched {
+				rejected[change.flag.Name] = true
+				result.reject(change.flag.Name, e)
+			}
+		}
+	}
+
+	config_mu.Lock()
+	for _, change := range changes {
+		if !rejected[change.flag.Name] {
+			config_values[change.flag.Name] = change.value
+			result.Changed = append(result.Changed, change.flag.Name)
+		}
+	}
+	last_reload = result
+	config_mu.Unlock()
+
+	logger.Info("config is reloaded", "source", source, "changed", result.Changed)
+	for _, name := range result.Restart {
+		logger.Warn("config is changed, it takes effect after restart", "source", source, "name", name)
+	}
+	for _, name := range result.Unknown {
+		logger.Warn("config is ignored, flag is not defined", "source", source, "name", name)
+	}
+	for _, r := range result.Rejected {
+		logger.Warn("config is rejected", "source", source, "name", r.Name, "error", r.Error)
+	}
+	return result, nil
+}
+
+// reloadConfig 重新读取配置文件和 DELAYED_JOB_* 环境变量并应用其中改变了的配置
+func reloadConfig(source string) (*reloadResult, error) {
+	nm := *config_file
+	if !fileExists(nm) {
+		nm = ""
+	}
+	settings, _, e := loadSettings(nm, nil)
+	if nil != e {
+		return nil, e
+	}
+	return applySettings(source, settings, false)
+}
+
+// watchConfig 在配置文件改变或收到 SIGHUP 时重新加载配置， 返回的函数用于停止
+func watchConfig() func() {
+	done := make(chan struct{})
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+
+	var ticker *time.Ticker
+	var tick <-chan time.Time
+	if *config_watch_interval > 0 {
+		ticker = time.NewTicker(*config_watch_interval)
+		tick = ticker.C
+	}
+
+	stat := func() (time.Time, int64) {
+		st, e := os.Stat(*config_file)
+		if nil != e {
+			return time.Time{}, -1
+		}
+		return st.ModTime(), st.Size()
+	}
+	modified_at, size := stat()
+
+	reload := func(source string) {
+		if _, e := reloadConfig(source); nil != e {
+			logger.Error("reload config failed", "source", source, "file", *config_file, "error", e)
+		}
+	}
+
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+		for {
+			select {
+			case <-done:
+				signal.Stop(hup)
+				if nil != ticker {
+					ticker.Stop()
+				}
+				return
+			case <-hup:
+				modified_at, size = stat()
+				reload("signal")
+			case <-tick:
+				m, s := stat()
+				if m.Equal(modified_at) && s == size {
+					continue
+				}
+				modified_at, size = m, s
+				if s < 0 {
+					continue
+				}
+				reload("file")
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			close(done)
+			<-stopped
+		})
+	}
+}
+
+func reloadConfigHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	result, e := reloadConfig("api")
+	if nil != e {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+	backend.audit(r, "reload_settings", 0, nil, result)
+
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	e = json.NewEncoder(w).Encode(result)
+	if nil != e {
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+}
+
+func lastReloadHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	config_mu.Lock()
+	result := last_reload
+	config_mu.Unlock()
+
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	e := json.NewEncoder(w).Encode(result)
+	if nil != e {
+		w.Header()["Content-Type"] = []string{"text/plain; charset=utf-8"}
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+}
@@ -0,0 +1,335 @@
+package delayed_job
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// resetConfigValues 清除测试中热加载的配置
+func resetConfigValues() {
+	config_mu.Lock()
+	defer config_mu.Unlock()
+	config_values = map[string]flag.Value{}
+}
+
+func TestApplySettings(t *testing.T) {
+	defer resetConfigValues()
+	old_batch_size := *bulk_batch_size
+
+	var notified map[string]interface{}
+	unwatch := onConfigChanged([]string{"bulk.*"}, func(values map[string]interface{}) error {
+		notified = values
+		return nil
+	})
+	defer unwatch()
+
+	result, e := applySettings("test", map[string]interface{}{
+		"bulk":    map[string]interface{}{"batch_size": json.Number("7")},
+		"http":    map[string]interface{}{"base_path": "/abc/"},
+		"history": map[string]interface{}{"ttl": "5h"},
+		"abc":     map[string]interface{}{"def": 1.0}}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	// 热加载不修改 flag 本身
+	if 7 != configInt("bulk.batch_size") || old_batch_size != *bulk_batch_size || "/" != *base_path {
+		t.Error("actual is", configInt("bulk.batch_size"), *bulk_batch_size, *base_path)
+	}
+	// 没有注册的配置要重启才能生效
+	if !reflect.DeepEqual([]string{"bulk.batch_size"}, result.Changed) ||
+		!reflect.DeepEqual([]string{"history.ttl", "http.base_path"}, result.Restart) ||
+		!reflect.DeepEqual([]string{"abc.def"}, result.Unknown) || 0 != len(result.Rejected) {
+		t.Errorf("actual is %#v", result)
+	}
+	if 7 != notified["bulk.batch_size"] {
+		t.Error("actual is", notified)
+	}
+
+	// 值没有改变时不通知
+	notified = nil
+	result, e = applySettings("test", map[string]interface{}{"bulk.batch_size": "7"}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 0 != len(result.Changed) || nil != notified {
+		t.Error("actual is", result.Changed, notified)
+	}
+
+	// 有无效的值时， atomic 为 true 时不修改任何配置
+	result, e = applySettings("test", map[string]interface{}{"bulk.batch_size": "8", "history.ttl": "abc"}, true)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 7 != configInt("bulk.batch_size") || 1 != len(result.Rejected) || "history.ttl" != result.Rejected[0].Name {
+		t.Errorf("actual is %d, %#v", configInt("bulk.batch_size"), result)
+	}
+
+	// 被拒绝的配置保持原来的值
+	unwatchTTL := onConfigChanged([]string{"history.ttl"}, func(map[string]interface{}) error {
+		return errors.New("ttl is rejected")
+	})
+	defer unwatchTTL()
+	result, e = applySettings("test", map[string]interface{}{"bulk.batch_size": "8", "history.ttl": "2h"}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 8 != configInt("bulk.batch_size") || *history_ttl != configDuration("history.ttl") {
+		t.Error("actual is", configInt("bulk.batch_size"), configDuration("history.ttl"))
+	}
+	if !reflect.DeepEqual([]string{"bulk.batch_size"}, result.Changed) ||
+		!reflect.DeepEqual([]rejectedSetting{{Name: "history.ttl", Error: "ttl is rejected"}}, result.Rejected) {
+		t.Errorf("actual is %#v", result)
+	}
+
+	if _, e := applySettings("test", map[string]interface{}{"bulk.batch_size": map[int]int{}}, false); nil == e {
+		t.Error("excepted error, actual is nil")
+	}
+}
+
+func TestApplySettingsListenerReadsConfig(t *testing.T) {
+	defer resetConfigValues()
+
+	// listener 中可以读取配置， 调用它时不持有 config_mu
+	var actual int
+	unwatch := onConfigChanged([]string{"bulk.*"}, func(values map[string]interface{}) error {
+		actual = configInt("bulk.batch_size")
+		return nil
+	})
+	defer unwatch()
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		if _, e := applySettings("test", map[string]interface{}{"bulk.batch_size": "9"}, false); nil != e {
+			t.Error(e)
+		}
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("applySettings is deadlocked")
+	}
+	if *bulk_batch_size != actual || 9 != configInt("bulk.batch_size") {
+		t.Error("actual is", actual, configInt("bulk.batch_size"))
+	}
+}
+
+// TestApplySettingsConcurrently 要用 go test -race 执行， 热加载时其它 goroutine 正在读取配置
+func TestApplySettingsConcurrently(t *testing.T) {
+	defer resetConfigValues()
+
+	unwatch := onConfigChanged([]string{"bulk.*"}, nil)
+	defer unwatch()
+
+	done := make(chan struct{})
+	var wait sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wait.Add(1)
+		go func() {
+			defer wait.Done()
+			for {
+				select {
+				case <-done:
+					return
+				default:
+				}
+				if configInt("bulk.batch_size") <= 0 || configString("mail.from") == "x" {
+					t.Error("batch_size is invalid")
+					return
+				}
+				_ = *bulk_batch_size
+			}
+		}()
+	}
+
+	for i := 1; i <= 100; i++ {
+		if _, e := applySettings("test", map[string]interface{}{"bulk.batch_size": strconv.Itoa(i)}, false); nil != e {
+			t.Error(e)
+			break
+		}
+	}
+	close(done)
+	wait.Wait()
+
+	if 100 != configInt("bulk.batch_size") {
+		t.Error("actual is", configInt("bulk.batch_size"))
+	}
+}
+
+func TestWorkerConfigChanged(t *testing.T) {
+	defer resetConfigValues()
+	old := configDuration("sleep_delay")
+
+	w := &worker{options: map[string]interface{}{}, reload: make(chan struct{}, 1)}
+	w.initialize(w.options)
+	unwatch := onConfigChanged(worker_flags, w.configChanged)
+	defer unwatch()
+
+	result, e := applySettings("test", map[string]interface{}{"sleep_delay": "0s"}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 1 != len(result.Rejected) || old != configDuration("sleep_delay") {
+		t.Errorf("actual is %v, %#v", configDuration("sleep_delay"), result)
+	}
+
+	result, e = applySettings("test", map[string]interface{}{"sleep_delay": "3s"}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if 0 != len(result.Rejected) {
+		t.Errorf("actual is %#v", result)
+	}
+	select {
+	case <-w.reload:
+		w.reloadOptions()
+	default:
+		t.Fatal("worker isn't notified")
+	}
+	if 3*time.Second != w.sleep_delay {
+		t.Error("actual is", w.sleep_delay)
+	}
+}
+
+func TestSMSLimiterConfigChanged(t *testing.T) {
+	defer resetConfigValues()
+	old := smsLimiter
+	defer func() {
+		smsLimiter = old
+	}()
+	smsLimiter = &SmsLimiter{dayLimit: 5, weekLimit: 6}
+
+	if _, e := applySettings("test", map[string]interface{}{"sms": map[string]interface{}{"limiter": map[string]interface{}{"day": 10.0}}}, false); nil != e {
+		t.Fatal(e)
+	}
+	if day, week, _ := smsLimiter.Limits(); 10 != day || 6 != week {
+		t.Error("actual is", day, week)
+	}
+
+	result, e := applySettings("test", map[string]interface{}{"sms.limiter.day": "-2"}, false)
+	if nil != e {
+		t.Fatal(e)
+	}
+	if day, _, _ := smsLimiter.Limits(); 1 != len(result.Rejected) || 10 != day || 10 != configInt("sms.limiter.day") {
+		t.Errorf("actual is %d, %#v", day, result)
+	}
+}
+
+func TestReloadConfig(t *testing.T) {
+	defer resetConfigValues()
+	old_file, old_interval := *config_file, *config_watch_interval
+	defer func() {
+		*config_file, *config_watch_interval = old_file, old_interval
+	}()
+
+	dir, e := ioutil.TempDir("", "delayed_job")
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer os.RemoveAll(dir)
+	*config_file = filepath.Join(dir, "delayed_job.conf")
+	*config_watch_interval = 10 * time.Millisecond
+	if e := ioutil.WriteFile(*config_file, []byte(`{}`), 0666); nil != e {
+		t.Fatal(e)
+	}
+
+	config_mu.Lock()
+	last_reload = nil
+	config_mu.Unlock()
+
+	stop := watchConfig()
+	defer stop()
+
+	if e := ioutil.WriteFile(*config_file, []byte(`{"mail": {"from": "a@example.com"}}`), 0666); nil != e {
+		t.Fatal(e)
+	}
+	reloaded := func() bool {
+		config_mu.Lock()
+		defer config_mu.Unlock()
+		return nil != last_reload && "file" == last_reload.Source
+	}
+	for i := 0; i < 200 && !reloaded(); i++ {
+		time.Sleep(10 * time.Millisecond)
+	}
+	stop()
+	if !reloaded() || "a@example.com" != configString("mail.from") {
+		t.Error("config isn't reloaded after the file is changed, actual is", configString("mail.from"))
+	}
+
+	if e := ioutil.WriteFile(*config_file, []byte(`{"mail": {"from": "b@example.com"}}`), 0666); nil != e {
+		t.Fatal(e)
+	}
+	front := &webFront{base_path: "/"}
+	w := httptest.NewRecorder()
+	front.ServeHTTP(w, httptest.NewRequest("POST", "/settings_file/reload", nil))
+	if http.StatusOK != w.Code || !strings.Contains(w.Body.String(), `"changed":["mail.from"]`) {
+		t.Error(w.Code, w.Body.String())
+	}
+	if "b@example.com" != configString("mail.from") {
+		t.Error("actual is", configString("mail.from"))
+	}
+
+	w = httptest.NewRecorder()
+	front.ServeHTTP(w, httptest.NewRequest("GET", "/settings_file/reload", nil))
+	if http.StatusOK != w.Code || !strings.Contains(w.Body.String(), `"source":"api"`) {
+		t.Error(w.Code, w.Body.String())
+	}
+}
+
+func TestSaveSettingsFile(t *testing.T) {
+	defer resetConfigValues()
+	old_file := *config_file
+	defer func() {
+		*config_file = old_file
+	}()
+
+	dir, e := ioutil.TempDir("", "delayed_job")
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer os.RemoveAll(dir)
+	*config_file = filepath.Join(dir, "delayed_job.conf")
+	if e := ioutil.WriteFile(*config_file, []byte(`{}`), 0666); nil != e {
+		t.Fatal(e)
+	}
+	os.Setenv("TEST_SAVE_MAIL_FROM", "c@example.com")
+	defer os.Unsetenv("TEST_SAVE_MAIL_FROM")
+
+	front := &webFront{base_path: "/"}
+	save := func(body string) *httptest.ResponseRecorder {
+		w := httptest.NewRecorder()
+		front.ServeHTTP(w, httptest.NewRequest("PUT", "/settings_file", strings.NewReader(body)))
+		return w
+	}
+
+	// 有无效的配置时不保存
+	if w := save(`{"bulk": {"batch_size": "abc"}}`); http.StatusBadRequest != w.Code || !strings.Contains(w.Body.String(), "bulk.batch_size") {
+		t.Error(w.Code, w.Body.String())
+	}
+	if bs, _ := ioutil.ReadFile(*config_file); "{}" != string(bs) {
+		t.Error("config file is changed -", string(bs))
+	}
+
+	// 保存的是原始的内容， 生效的是展开环境变量后的值
+	if w := save(`{"mail": {"from": "${TEST_SAVE_MAIL_FROM}"}}`); http.StatusOK != w.Code {
+		t.Error(w.Code, w.Body.String())
+	}
+	if "c@example.com" != configString("mail.from") {
+		t.Error("actual is", configString("mail.from"))
+	}
+	if bs, _ := ioutil.ReadFile(*config_file); !strings.Contains(string(bs), "${TEST_SAVE_MAIL_FROM}") {
+		t.Error("config file is invalid -", string(bs))
+	}
+}
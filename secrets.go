@@ -0,0 +1,478 @@
+package delayed_job
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"flag"
+	"io"
+	"os"
+	"path"
+	"strings"
+	"sync"
+)
+
+// 任务中的密码等字段使用信封加密： 每个任务生成一个数据密钥， 用它加密字段，
+// 数据密钥再用主密钥加密后和主密钥的 id 一起保存在 handler 的 _encryption 字段中，
+// 更换主密钥时只需要重新加密数据密钥。 加密时使用的字段模式（redact.fields 和 secrets.fields）也保存在
+// _encryption 中， 解密时只解密匹配它们的字段， 其它以 "enc:" 开头的字符串保持不变， 之后修改配置也不影响已有的任务。
+const (
+	secrets_env      = "DELAYED_JOB_SECRET_KEYS"
+	encryption_key   = "_encryption"
+	encrypted_prefix = "enc:"
+)
+
+var (
+	secrets_key_file = flag.String("secrets.key_file", "", "the file of the master keys, one key per line in the format of 'key_id:base64_key', keys are read from the env "+secrets_env+"(split by ',') too")
+	secrets_key_id   = flag.String("secrets.key_id", "", "the id of the master key that is used to encrypt, the first key is used if it is empty")
+	secrets_fields   = flag.String("secrets.fields", "", "the extra names(or patterns) of the fields that are encrypted in the job payload, split by ',', the secret fields of the redaction are always encrypted")
+
+	master_keys_lock sync.Mutex
+	master_keys      *masterKeys
+)
+
+type masterKeys struct {
+	active string
+	keys   map[string][]byte
+}
+
+func parseMasterKeys(txt string, keys *masterKeys) error {
+	scanner := bufio.NewScanner(strings.NewReader(txt))
+	for scanner.Scan() {
+		for _, line := range strings.Split(scanner.Text(), ",") {
+			line = strings.TrimSpace(line)
+			if "" == line || strings.HasPrefix(line, "#") {
+				continue
+			}
+
+			kv := strings.SplitN(line, ":", 2)
+			if 2 != len(kv) || "" == strings.TrimSpace(kv[0]) {
+				return errors.New("master key '" + line + "' is invalid, it must be 'key_id:base64_key'")
+			}
+			id := strings.TrimSpace(kv[0])
+			key, e := base64.StdEncoding.DecodeString(strings.TrimSpace(kv[1]))
+			if nil != e {
+				return errors.New("master key '" + id + "' is invalid, " + e.Error())
+			}
+			if 16 != len(key) && 24 != len(key) && 32 != len(key) {
+				return errors.New("master key '" + id + "' is invalid, the length must be 16, 24 or 32 bytes")
+			}
+			if "" == keys.active {
+				keys.active = id
+			}
+			keys.keys[id] = key
+		}
+	}
+	return scanner.Err()
+}
+
+func loadMasterKeys() (*masterKeys, error) {
+	keys := &masterKeys{keys: map[string][]byte{}}
+	if "" != *secrets_key_file {
+		bs, e := os.ReadFile(*secrets_key_file)
+		if nil != e {
+			return nil, errors.New("read master keys failed, " + e.Error())
+		}
+		if e = parseMasterKeys(string(bs), keys); nil != e {
+			return nil, e
+		}
+	}
+	if e := parseMasterKeys(os.Getenv(secrets_env), keys); nil != e {
+		return nil, e
+	}
+
+	if "" != *secrets_key_id {
+		if _, ok := keys.keys[*secrets_key_id]; !ok {
+			return nil, errors.New("master key '" + *secrets_key_id + "' isn't found")
+		}
+		keys.active = *secrets_key_id
+	}
+	return keys, nil
+}
+
+// initSecrets 重新载入主密钥， 没有主密钥时不加密任务
+func initSecrets() error {
+	keys, e := loadMasterKeys()
+	if nil != e {
+		return e
+	}
+
+	master_keys_lock.Lock()
+	master_keys = keys
+	master_keys_lock.Unlock()
+	return nil
+}
+
+func currentMasterKeys() (*masterKeys, error) {
+	master_keys_lock.Lock()
+	defer master_keys_lock.Unlock()
+	if nil == master_keys {
+		keys, e := loadMasterKeys()
+		if nil != e {
+			return nil, e
+		}
+		master_keys = keys
+	}
+	return master_keys, nil
+}
+
+// encryptionPatterns 返回需要加密的字段的模式， 包括屏蔽的字段（redact.fields）和 secrets.fields， 用 ',' 分隔
+func encryptionPatterns() string {
+	patterns := redactPatterns()
+	for _, pattern := range strings.Split(*secrets_fields, ",") {
+		if pattern = strings.ToLower(strings.TrimSpace(pattern)); "" != pattern {
+			patterns = append(patterns, pattern)
+		}
+	}
+	return strings.Join(patterns, ",")
+}
+
+// isEncryptedField 判断字段名是否匹配 patterns 中的某个模式， 和 isSecretField 一样也匹配 '.' 之后的部分
+func isEncryptedField(patterns, name string) bool {
+	name = strings.ToLower(name)
+	short := name
+	if idx := strings.LastIndex(name, "."); idx >= 0 {
+		short = name[idx+1:]
+	}
+	for _, pattern := range strings.Split(patterns, ",") {
+		pattern = strings.ToLower(strings.TrimSpace(pattern))
+		if "" == pattern {
+			continue
+		}
+		if matched, _ := path.Match(pattern, name); matched {
+			return true
+		}
+		if matched, _ := path.Match(pattern, short); matched {
+			return true
+		}
+	}
+	return false
+}
+
+func seal(key, plaintext []byte) ([]byte, error) {
+	block, e := aes.NewCipher(key)
+	if nil != e {
+		return nil, e
+	}
+	gcm, e := cipher.NewGCM(block)
+	if nil != e {
+		return nil, e
+	}
+	nonce := make([]byte, gcm.NonceSize(), gcm.NonceSize()+len(plaintext)+gcm.Overhead())
+	if _, e = io.ReadFull(rand.Reader, nonce); nil != e {
+		return nil, e
+	}
+	return gcm.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func unseal(key, ciphertext []byte) ([]byte, error) {
+	block, e := aes.NewCipher(key)
+	if nil != e {
+		return nil, e
+	}
+	gcm, e := cipher.NewGCM(block)
+	if nil != e {
+		return nil, e
+	}
+	if len(ciphertext) < gcm.NonceSize() {
+		return nil, errors.New("ciphertext is too short")
+	}
+	return gcm.Open(nil, ciphertext[:gcm.NonceSize()], ciphertext[gcm.NonceSize():], nil)
+}
+
+func encryptValue(value interface{}, dek []byte, fields string, is_secret bool) (interface{}, bool, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		copied := make(map[string]interface{}, len(v))
+		changed := false
+		for k, item := range v {
+			encrypted, ok, e := encryptValue(item, dek, fields, is_secret || isEncryptedField(fields, k))
+			if nil != e {
+				return nil, false, e
+			}
+			copied[k] = encrypted
+			changed = changed || ok
+		}
+		return copied, changed, nil
+	case []interface{}:
+		copied := make([]interface{}, len(v))
+		changed := false
+		for i, item := range v {
+			encrypted, ok, e := encryptValue(item, dek, fields, is_secret)
+			if nil != e {
+				return nil, false, e
+			}
+			copied[i] = encrypted
+			changed = changed || ok
+		}
+		return copied, changed, nil
+	case string:
+		if !is_secret || "" == v {
+			return value, false, nil
+		}
+		bs, e := seal(dek, []byte(v))
+		if nil != e {
+			return nil, false, e
+		}
+		return encrypted_prefix + base64.StdEncoding.EncodeToString(bs), true, nil
+	default:
+		return value, false, nil
+	}
+}
+
+func decryptValue(value interface{}, dek []byte, fields string, is_secret bool) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			decrypted, e := decryptValue(item, dek, fields, is_secret || isEncryptedField(fields, k))
+			if nil != e {
+				return nil, errors.New("decrypt '" + k + "' failed, " + e.Error())
+			}
+			v[k] = decrypted
+		}
+		return v, nil
+	case []interface{}:
+		for i, item := range v {
+			decrypted, e := decryptValue(item, dek, fields, is_secret)
+			if nil != e {
+				return nil, e
+			}
+			v[i] = decrypted
+		}
+		return v, nil
+	case string:
+		if !is_secret || !strings.HasPrefix(v, encrypted_prefix) {
+			return value, nil
+		}
+		bs, e := base64.StdEncoding.DecodeString(strings.TrimPrefix(v, encrypted_prefix))
+		if nil != e {
+			return nil, e
+		}
+		bs, e = unseal(dek, bs)
+		if nil != e {
+			return nil, e
+		}
+		return string(bs), nil
+	default:
+		return value, nil
+	}
+}
+
+// encryptAttributes 返回加密了密码等字段的副本， 没有主密钥时返回原来的值
+func encryptAttributes(attributes map[string]interface{}) (map[string]interface{}, error) {
+	if _, ok := attributes[encryption_key]; ok {
+		return attributes, nil
+	}
+
+	keys, e := currentMasterKeys()
+	if nil != e {
+		return nil, e
+	}
+	if "" == keys.active {
+		return attributes, nil
+	}
+
+	dek := make([]byte, 32)
+	if _, e = io.ReadFull(rand.Reader, dek); nil != e {
+		return nil, errors.New("generate data key failed, " + e.Error())
+	}
+
+	patterns := encryptionPatterns()
+	encrypted, changed, e := encryptValue(attributes, dek, patterns, false)
+	if nil != e {
+		return nil, errors.New("encrypt job failed, " + e.Error())
+	}
+	if !changed {
+		return attributes, nil
+	}
+
+	wrapped, e := seal(keys.keys[keys.active], dek)
+	if nil != e {
+		return nil, errors.New("encrypt data key failed, " + e.Error())
+	}
+	copied := encrypted.(map[string]interface{})
+	copied[encryption_key] = map[string]interface{}{"kid": keys.active,
+		"dek":      base64.StdEncoding.EncodeToString(wrapped),
+		"patterns": patterns}
+	return copied, nil
+}
+
+func unwrapDataKey(attributes map[string]interface{}) (string, []byte, error) {
+	envelope, ok := attributes[encryption_key].(map[string]interface{})
+	if !ok {
+		return "", nil, errors.New("'" + encryption_key + "' is invalid")
+	}
+	kid := stringWithDefault(envelope, "kid", "")
+	wrapped, e := base64.StdEncoding.DecodeString(stringWithDefault(envelope, "dek", ""))
+	if nil != e {
+		return "", nil, errors.New("data key is invalid, " + e.Error())
+	}
+
+	keys, e := currentMasterKeys()
+	if nil != e {
+		return "", nil, e
+	}
+	key, ok := keys.keys[kid]
+	if !ok {
+		return "", nil, errors.New("master key '" + kid + "' isn't found")
+	}
+	dek, e := unseal(key, wrapped)
+	if nil != e {
+		return "", nil, errors.New("decrypt data key failed, " + e.Error())
+	}
+	return kid, dek, nil
+}
+
+// encryptedFields 返回加密时使用的字段模式， 旧的任务中没有保存它， 这时使用当前的配置
+func encryptedFields(attributes map[string]interface{}) string {
+	envelope, _ := attributes[encryption_key].(map[string]interface{})
+	if patterns, ok := envelope["patterns"].(string); ok {
+		return patterns
+	}
+	return encryptionPatterns()
+}
+
+// decryptAttributes 解密任务中的字段， 它会直接修改 attributes
+func decryptAttributes(attributes map[string]interface{}) (map[string]interface{}, error) {
+	if _, ok := attributes[encryption_key]; !ok {
+		return attributes, nil
+	}
+
+	_, dek, e := unwrapDataKey(attributes)
+	if nil != e {
+		return nil, e
+	}
+	fields := encryptedFields(attributes)
+	delete(attributes, encryption_key)
+	if _, e = decryptValue(attributes, dek, fields, false); nil != e {
+		return nil, e
+	}
+	return attributes, nil
+}
+
+// decryptEdited 删除从 web ui 提交的 handler 中的 _encryption， 并解密其中残留的密文，
+// 它们是用任务原来的数据密钥加密的， 保存时会用新的数据密钥重新加密
+func decryptEdited(attributes map[string]interface{}, original string) error {
+	delete(attributes, encryption_key)
+
+	envelope, e := decodeHandler(original)
+	if nil != e {
+		return nil
+	}
+	if _, ok := envelope[encryption_key]; !ok {
+		return nil
+	}
+	_, dek, e := unwrapDataKey(envelope)
+	if nil != e {
+		return e
+	}
+	restoreCiphertexts(attributes, dek, encryptedFields(envelope), false)
+	return nil
+}
+
+// restoreCiphertexts 解密 value 中能解密的密文， 不能解密的保持不变（如用户输入的以 "enc:" 开头的新密码）
+func restoreCiphertexts(value interface{}, dek []byte, fields string, is_secret bool) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for k, item := range v {
+			v[k] = restoreCiphertexts(item, dek, fields, is_secret || isEncryptedField(fields, k))
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = restoreCiphertexts(item, dek, fields, is_secret)
+		}
+	case string:
+		if decrypted, e := decryptValue(v, dek, fields, is_secret); nil == e {
+			return decrypted
+		}
+	}
+	return value
+}
+
+func decodeHandler(handler string) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(strings.NewReader(handler))
+	decoder.UseNumber()
+	var attributes map[string]interface{}
+	if e := decoder.Decode(&attributes); nil != e {
+		return nil, e
+	}
+	return attributes, nil
+}
+
+func encodeHandler(attributes map[string]interface{}) (string, error) {
+	var buffer bytes.Buffer
+	encoder := json.NewEncoder(&buffer)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if e := encoder.Encode(attributes); nil != e {
+		return "", e
+	}
+	return strings.TrimSpace(buffer.String()), nil
+}
+
+// encryptHandler 加密保存到数据库中的 handler
+func encryptHandler(handler string) (string, error) {
+	attributes, e := decodeHandler(handler)
+	if nil != e {
+		return "", deserializationError(e)
+	}
+	encrypted, e := encryptAttributes(attributes)
+	if nil != e {
+		return "", e
+	}
+	if _, ok := encrypted[encryption_key]; !ok {
+		return handler, nil
+	}
+	return encodeHandler(encrypted)
+}
+
+// rotateHandler 用当前的主密钥重新加密数据密钥， 未加密的 handler 会被加密
+func rotateHandler(handler string) (string, bool, error) {
+	attributes, e := decodeHandler(handler)
+	if nil != e {
+		return "", false, deserializationError(e)
+	}
+
+	keys, e := currentMasterKeys()
+	if nil != e {
+		return "", false, e
+	}
+	if "" == keys.active {
+		return "", false, errors.New("master key is missing")
+	}
+
+	if _, ok := attributes[encryption_key]; !ok {
+		encrypted, e := encryptAttributes(attributes)
+		if nil != e {
+			return "", false, e
+		}
+		if _, ok := encrypted[encryption_key]; !ok {
+			return handler, false, nil
+		}
+		s, e := encodeHandler(encrypted)
+		return s, nil == e, e
+	}
+
+	kid, dek, e := unwrapDataKey(attributes)
+	if nil != e {
+		return "", false, e
+	}
+	if kid == keys.active {
+		return handler, false, nil
+	}
+
+	wrapped, e := seal(keys.keys[keys.active], dek)
+	if nil != e {
+		return "", false, errors.New("encrypt data key failed, " + e.Error())
+	}
+	attributes[encryption_key] = map[string]interface{}{"kid": keys.active,
+		"dek":      base64.StdEncoding.EncodeToString(wrapped),
+		"patterns": encryptedFields(attributes)}
+	s, e := encodeHandler(attributes)
+	return s, nil == e, e
+}
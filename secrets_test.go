@@ -0,0 +1,250 @@
+package delayed_job
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"strings"
+	"testing"
+	"time"
+)
+
+func withMasterKeys(t *testing.T, keys string, cb func()) {
+	old := os.Getenv(secrets_env)
+	os.Setenv(secrets_env, keys)
+	defer func() {
+		os.Setenv(secrets_env, old)
+		master_keys = nil
+	}()
+
+	if e := initSecrets(); nil != e {
+		t.Error(e)
+		return
+	}
+	cb()
+}
+
+func TestEncryptHandler(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	key2 := base64.StdEncoding.EncodeToString([]byte("abcdef0123456789"))
+
+	var encrypted string
+	withMasterKeys(t, "k1:"+key1, func() {
+		var e error
+		encrypted, e = encryptHandler(`{"type":"mail","password":"abc","auth":{"corp_secret":"x"},"content":"hello"}`)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if strings.Contains(encrypted, "abc") || strings.Contains(encrypted, `"x"`) {
+			t.Error("secret isn't encrypted -", encrypted)
+		}
+		if !strings.Contains(encrypted, "hello") {
+			t.Error("content is encrypted -", encrypted)
+		}
+
+		job := &Job{handler: encrypted}
+		attributes, e := job.attributes()
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if "abc" != attributes["password"] {
+			t.Error("excepted password is abc, actual is", attributes["password"])
+		}
+		if "x" != attributes["auth"].(map[string]interface{})["corp_secret"] {
+			t.Error("excepted corp_secret is x, actual is", attributes["auth"])
+		}
+		if _, ok := attributes[encryption_key]; ok {
+			t.Error("envelope isn't removed")
+		}
+	})
+
+	withMasterKeys(t, "k2:"+key2+",k1:"+key1, func() {
+		rotated, ok, e := rotateHandler(encrypted)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if !ok {
+			t.Error("handler isn't rotated")
+			return
+		}
+
+		var attributes map[string]interface{}
+		if e := json.Unmarshal([]byte(rotated), &attributes); nil != e {
+			t.Error(e)
+			return
+		}
+		if "k2" != attributes[encryption_key].(map[string]interface{})["kid"] {
+			t.Error("excepted kid is k2, actual is", attributes[encryption_key])
+		}
+
+		_, ok, e = rotateHandler(rotated)
+		if nil != e || ok {
+			t.Error("handler is rotated again -", e)
+		}
+		encrypted = rotated
+	})
+
+	withMasterKeys(t, "k2:"+key2, func() {
+		job := &Job{handler: encrypted}
+		attributes, e := job.attributes()
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if "abc" != attributes["password"] {
+			t.Error("excepted password is abc, actual is", attributes["password"])
+		}
+	})
+
+	withMasterKeys(t, "k1:"+key1, func() {
+		job := &Job{handler: encrypted}
+		if _, e := job.attributes(); nil == e || !strings.Contains(e.Error(), "k2") {
+			t.Error("excepted error is master key 'k2' isn't found, actual is", e)
+		}
+	})
+}
+
+func TestEncryptHandlerWithoutKeys(t *testing.T) {
+	withMasterKeys(t, "", func() {
+		handler := `{"type":"mail","password":"abc"}`
+		encrypted, e := encryptHandler(handler)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if handler != encrypted {
+			t.Error("handler is changed -", encrypted)
+		}
+	})
+}
+
+func TestParseMasterKeys(t *testing.T) {
+	keys := &masterKeys{keys: map[string][]byte{}}
+	if e := parseMasterKeys("k1:abc", keys); nil == e {
+		t.Error("excepted error for the short key")
+	}
+	if e := parseMasterKeys("abc", keys); nil == e {
+		t.Error("excepted error for the key without id")
+	}
+}
+
+func TestEncryptHandlerWithPrefixedText(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+
+	withMasterKeys(t, "k1:"+key1, func() {
+		encrypted, e := encryptHandler(`{"type":"mail","password":"enc:abc","content":"enc:hello"}`)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if strings.Contains(encrypted, "enc:abc") {
+			t.Error("secret isn't encrypted -", encrypted)
+		}
+
+		job := &Job{handler: encrypted}
+		attributes, e := job.attributes()
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if "enc:abc" != attributes["password"] {
+			t.Error("excepted password is enc:abc, actual is", attributes["password"])
+		}
+		if "enc:hello" != attributes["content"] {
+			t.Error("excepted content is enc:hello, actual is", attributes["content"])
+		}
+	})
+}
+
+func TestRotateJobKeysWithConcurrentEdit(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	key2 := base64.StdEncoding.EncodeToString([]byte("abcdef0123456789"))
+
+	backendTest(t, func(backend *dbBackend) {
+		withMasterKeys(t, "k1:"+key1, func() {
+			e := backend.enqueue(1, 0, "", 1, "", time.Time{}, map[string]interface{}{"type": "test", "password": "abc", "content": "old"})
+			if nil != e {
+				t.Error(e)
+				return
+			}
+		})
+
+		var id int64
+		var stale string
+		if e := backend.db.QueryRow("SELECT id, handler FROM "+*table_name).Scan(&id, &stale); nil != e {
+			t.Error(e)
+			return
+		}
+
+		withMasterKeys(t, "k2:"+key2+",k1:"+key1, func() {
+			// 读取后 handler 被修改了
+			edited, e := encryptHandler(`{"type":"test","password":"abc","content":"new"}`)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			if e := backend.update(id, map[string]interface{}{"@handler": edited}); nil != e {
+				t.Error(e)
+				return
+			}
+
+			ok, e := backend.rotateJobKeys(id, stale)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			if !ok {
+				t.Error("handler isn't rotated")
+			}
+
+			job, e := backend.findJob(id)
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			attributes, e := job.attributes()
+			if nil != e {
+				t.Error(e)
+				return
+			}
+			if "new" != attributes["content"] {
+				t.Error("the edit is lost, content is", attributes["content"])
+			}
+			if !strings.Contains(job.handler, `"k2"`) {
+				t.Error("handler isn't rotated -", job.handler)
+			}
+		})
+	})
+}
+
+func TestDecryptHandlerAfterRedactFieldsChanged(t *testing.T) {
+	key1 := base64.StdEncoding.EncodeToString([]byte("0123456789abcdef0123456789abcdef"))
+	old := *redact_fields
+	defer func() { *redact_fields = old }()
+
+	withMasterKeys(t, "k1:"+key1, func() {
+		*redact_fields = "api_key"
+		encrypted, e := encryptHandler(`{"type":"test","api_key":"xyz"}`)
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if strings.Contains(encrypted, "xyz") {
+			t.Error("api_key isn't encrypted -", encrypted)
+		}
+
+		*redact_fields = ""
+		job := &Job{handler: encrypted}
+		attributes, e := job.attributes()
+		if nil != e {
+			t.Error(e)
+			return
+		}
+		if "xyz" != attributes["api_key"] {
+			t.Error("excepted api_key is xyz, actual is", attributes["api_key"])
+		}
+	})
+}
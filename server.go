@@ -1,42 +1,36 @@
 package delayed_job
 
 import (
+	"bytes"
+	"database/sql"
 	"encoding/json"
 	"errors"
 	_ "expvar"
 	"flag"
 	"fmt"
 	"io"
-	"log"
+	"io/ioutil"
 	"net/http"
 	_ "net/http/pprof"
+	"net/url"
 	"os"
 	"path/filepath"
 	"regexp"
 	"runtime"
 	"strconv"
 	"strings"
-
-	"github.com/rakyll/statik/fs"
-
-	_ "github.com/runner-mei/delayed_job/statik"
 )
 
 var (
-	config_file = flag.String("delayed-config", "", "the config file name")
-	cd_dir, _   = os.Getwd()
+	config_file = flag.String("delayed-config", "", "the config file name, the format is json, yaml(.yaml or .yml) or toml(.toml)")
 
-	retry_list = []*regexp.Regexp{regexp.MustCompile(`^/?[0-9]+/retry/?$`),
-		regexp.MustCompile(`^/?delayed_jobs/[0-9]+/retry/?$`),
-		regexp.MustCompile(`^/?delayed_jobs/delayed_jobs/[0-9]+/retry/?$`)}
+	// config_names 是查找默认配置文件时的文件名
+	config_names = []string{"delayed_job.conf", "delayed_job.yaml", "delayed_job.yml", "delayed_job.toml"}
 
-	delete_by_id_list = []*regexp.Regexp{regexp.MustCompile(`^/?[0-9]+/delete/?$`),
-		regexp.MustCompile(`^/?delayed_jobs/[0-9]+/delete/?$`),
-		regexp.MustCompile(`^/?delayed_jobs/delayed_jobs/[0-9]+/delete/?$`)}
-
-	job_id_list = []*regexp.Regexp{regexp.MustCompile(`^/?[0-9]+/?$`),
-		regexp.MustCompile(`^/?delayed_jobs/[0-9]+/?$`),
-		regexp.MustCompile(`^/?delayed_jobs/delayed_jobs/[0-9]+/?$`)}
+	// 路径中 /delayed_jobs 等旧的前缀已在 routePath 中去掉
+	retry_pattern        = regexp.MustCompile(`^/[0-9]+/retry/?$`)
+	delete_by_id_pattern = regexp.MustCompile(`^/[0-9]+/delete/?$`)
+	job_id_pattern       = regexp.MustCompile(`^/[0-9]+/?$`)
 )
 
 func abs(pa string) string {
@@ -48,12 +42,16 @@ func abs(pa string) string {
 }
 
 func searchFile() (string, bool) {
-	files := []string{*config_file,
-		filepath.Join("data", "conf", "delayed_job.conf"),
-		filepath.Join("data", "etc", "delayed_job.conf"),
-		filepath.Join("..", "data", "conf", "delayed_job.conf"),
-		filepath.Join("..", "data", "etc", "delayed_job.conf"),
-		"/etc/tpt/delayed_job.conf"}
+	files := []string{*config_file}
+	for _, dir := range []string{filepath.Join("data", "conf"),
+		filepath.Join("data", "etc"),
+		filepath.Join("..", "data", "conf"),
+		filepath.Join("..", "data", "etc"),
+		"/etc/tpt"} {
+		for _, nm := range config_names {
+			files = append(files, filepath.Join(dir, nm))
+		}
+	}
 
 	for _, file := range files {
 		if st, e := os.Stat(file); nil == e && nil != st && !st.IsDir() {
@@ -78,26 +76,59 @@ func searchFile() (string, bool) {
 	}
 }
 
+// loadDefaultConfig 加载配置文件和 DELAYED_JOB_* 环境变量， 没有指定配置文件时查找默认的配置文件，
+// 配置文件不存在时只加载环境变量， 提示信息输出到 w 中
+func loadDefaultConfig(w io.Writer) error {
+	file := *config_file
+	found := fileExists(file)
+	if "" == file {
+		file, found = searchFile()
+		flag.Set("delayed-config", file)
+	}
+	fmt.Fprintln(w, "[info] config file is '"+file+"'")
+
+	if !found {
+		fmt.Fprintln(w, "[warn] file '"+file+"' isn't found, only the environment variables are loaded.")
+		file = ""
+	} else {
+		fmt.Fprintln(w, "[warn] load file '"+file+"'.")
+	}
+	return loadConfig(file, nil, false)
+}
+
 func Main(runMode, dbDrv, dbURL string, runHttp func(http.Handler)) error {
 	default_actuals = loadActualFlags(nil)
 	initDB()
 
-	if *config_file == "" {
-		file, found := searchFile()
-		flag.Set("delayed-config", file)
-		fmt.Println("[info] config file is '" + file + "'")
+	if e := loadDefaultConfig(os.Stdout); nil != e {
+		return e
+	}
 
-		if found {
-			fmt.Println("[warn] load file '" + file + "'.")
-			e := loadConfig(file, nil, false)
-			if nil != e {
-				return errors.New("load file '" + file + "' failed, " + e.Error())
-			}
-		}
+	closeLogger, e := initLogger()
+	if nil != e {
+		return e
 	}
+	defer closeLogger()
 
-	fmt.Println("useTLS=", *default_mail_useTLS)
-	fmt.Println("useFQDN=", *default_mail_useFQDN)
+	if e = initSecrets(); nil != e {
+		return e
+	}
+
+	auth, e := newAuthenticator()
+	if nil != e {
+		return e
+	}
+	if nil != auth && 0 != len(auth.mtls_users) && "" == *http_client_ca {
+		logger.Warn("http.client_ca is empty, the mtls users can login only if runHttp verifies the client certificates")
+	}
+
+	if "init_db" != runMode && "migrate" != runMode {
+		shutdownTracing, e := initTracing()
+		if nil != e {
+			return e
+		}
+		defer shutdownTracing()
+	}
 
 	if !fileExists(gammu_config) {
 		for _, s := range []string{"data/conf/gammu.conf",
@@ -112,6 +143,15 @@ func Main(runMode, dbDrv, dbURL string, runHttp func(http.Handler)) error {
 		}
 	}
 
+	switch runMode {
+	case "console", "backend", "all":
+		autoMigrate(dbDrv, dbURL)
+
+		// 配置文件改变或收到 SIGHUP 时重新加载配置
+		stopWatch := watchConfig()
+		defer stopWatch()
+	}
+
 	switch runMode {
 	case "init_db":
 		ctx := map[string]interface{}{}
@@ -277,6 +317,35 @@ END;`,
 			}
 		}
 
+		if e = backend.migrate(os.Stdout); nil != e {
+			return e
+		}
+
+	case "migrate":
+		// 升级时使用， 只创建缺少的表， 不会删除任务
+		ctx := map[string]interface{}{}
+		backend, e := newBackend(dbDrv, dbURL, ctx)
+		if nil != e {
+			return e
+		}
+		defer backend.Close()
+
+		if e = backend.migrate(os.Stdout); nil != e {
+			return e
+		}
+	case "rotate-keys":
+		ctx := map[string]interface{}{}
+		backend, e := newBackend(dbDrv, dbURL, ctx)
+		if nil != e {
+			return e
+		}
+		defer backend.Close()
+
+		count, e := backend.rotateKeys()
+		if nil != e {
+			return e
+		}
+		logger.Info("rotate keys is completed", "jobs", count)
 	case "console":
 		ctx := map[string]interface{}{}
 		backend, e := newBackend(dbDrv, dbURL, ctx)
@@ -297,7 +366,13 @@ END;`,
 			os.Exit(1)
 		}
 		defer removePidFile(*pidFile)
-		httpServe(backend, findFs(), runHttp)
+
+		front, e := newWebFront(backend, auth)
+		if nil != e {
+			return e
+		}
+		logger.Info("the workers don't run in this process, /events only has the events of the http api, use '-mode all' to get the events of the workers")
+		runHttp(front)
 	case "backend":
 		w, e := newWorker(map[string]interface{}{
 			"db_drv": dbDrv,
@@ -344,21 +419,13 @@ END;`,
 			os.Exit(1)
 		}
 		defer removePidFile(*pidFile)
-		go httpServe(w.backend, findFs(), runHttp)
-		w.RunForever()
-	}
-	return nil
-}
 
-func findFs() http.Handler {
-	for _, s := range []string{filepath.Join(".", "index.html"),
-		filepath.Join("public", "index.html"),
-		filepath.Join("..", "public", "index.html"),
-		filepath.Join("lib", "delayed_jobs", "index.html")} {
-		if fileExists(s) {
-			log.Println("public directory is found in the", s)
-			return http.FileServer(http.Dir(filepath.Dir(s)))
+		front, e := newWebFront(w.backend, auth)
+		if nil != e {
+			return e
 		}
+		go runHttp(front)
+		w.RunForever()
 	}
 	return nil
 }
@@ -371,21 +438,6 @@ func fileExists(nm string) bool {
 	return !fs.IsDir()
 }
 
-func fileHandler(w http.ResponseWriter, r *http.Request, path, default_content string) {
-	var name string
-	if filepath.IsAbs(path) {
-		name = path
-	} else {
-		name = cd_dir + path
-	}
-	if fileExists(name) {
-		http.ServeFile(w, r, name)
-		return
-	}
-
-	io.WriteString(w, default_content)
-}
-
 // func indexHandlerWithMessage(w http.ResponseWriter, r *http.Request, level, message string) {
 // 	io.WriteString(w, index_html_1)
 
@@ -418,14 +470,32 @@ func fileHandler(w http.ResponseWriter, r *http.Request, path, default_content s
 // 	return self.backend.update(id, map[string]interface{}{"@failed_at": nil})
 // }
 
-func queryHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend, params map[string]interface{}) {
-	results, e := backend.where(params)
+func queryHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend, state string) {
+	values := r.URL.Query()
+	values.Set("state", state)
+	q, e := parseJobQuery(values, 0)
+	if nil != e {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	results, cursor, e := backend.findJobs(q)
 	if nil != e {
 		w.WriteHeader(http.StatusInternalServerError)
 		io.WriteString(w, e.Error())
 		return
 	}
 
+	// 旧的接口中 handler_id 是 sql.NullString 的格式， 保持不变
+	for _, result := range results {
+		handler_id, ok := result["handler_id"].(string)
+		result["handler_id"] = sql.NullString{String: handler_id, Valid: ok}
+	}
+
+	if "" != cursor {
+		w.Header().Set("X-Next-Cursor", cursor)
+	}
 	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
 	e = json.NewEncoder(w).Encode(results)
 	if nil != e {
@@ -437,22 +507,22 @@ func queryHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend, pa
 }
 
 func allHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
-	queryHandler(w, r, backend, nil)
+	queryHandler(w, r, backend, "all")
 }
 
 func failedHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
 	//return self.where("failed_at IS NOT NULL")
-	queryHandler(w, r, backend, map[string]interface{}{"@failed_at": "[notnull]"})
+	queryHandler(w, r, backend, "failed")
 }
 
 func queuedHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
-	queryHandler(w, r, backend, map[string]interface{}{"@failed_at": nil, "locked_by": nil})
 	// 	return self.where("failed_at IS NULL AND locked_by IS NULL")
+	queryHandler(w, r, backend, "queued")
 }
 
 func activeHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
 	//return self.where("failed_at IS NULL AND locked_by IS NOT NULL")
-	queryHandler(w, r, backend, map[string]interface{}{"@failed_at": nil, "locked_by": "[notnull]"})
+	queryHandler(w, r, backend, "active")
 }
 
 func countsHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
@@ -467,11 +537,11 @@ func countsHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
 	if nil != e {
 		goto failed
 	}
-	queued_size, e = backend.count(map[string]interface{}{"@failed_at": nil, "locked_by": nil})
+	queued_size, e = backend.count(map[string]interface{}{"@failed_at": nil, "@locked_by": nil})
 	if nil != e {
 		goto failed
 	}
-	active_size, e = backend.count(map[string]interface{}{"@failed_at": nil, "locked_by": "[notnull]"})
+	active_size, e = backend.count(map[string]interface{}{"@failed_at": nil, "@locked_by": "[notnull]"})
 	if nil != e {
 		goto failed
 	}
@@ -488,6 +558,11 @@ failed:
 }
 
 func testJobHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	if "true" == r.URL.Query().Get("dry_run") {
+		previewJobHandler(w, r, backend)
+		return
+	}
+
 	decoder := json.NewDecoder(r.Body)
 	decoder.UseNumber()
 	var ent map[string]interface{}
@@ -526,77 +601,149 @@ func testJobHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend)
 	return
 }
 
+// pushErrorStatus 将 pushJobs 的错误转换为对应的状态码
+func pushErrorStatus(e error) int {
+	switch e {
+	case errIdempotencyInProgress:
+		return http.StatusConflict
+	case errIdempotencyMismatch:
+		return http.StatusUnprocessableEntity
+	}
+	return http.StatusInternalServerError
+}
+
+func writePushResult(w http.ResponseWriter, replayed bool, result interface{}) {
+	if replayed {
+		w.Header().Set("Idempotent-Replayed", "true")
+	}
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	w.WriteHeader(http.StatusOK)
+	json.NewEncoder(w).Encode(result)
+}
+
 func pushHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
-	decoder := json.NewDecoder(r.Body)
+	ctx, span := tracer.Start(extractTraceContextFromRequest(r), "push")
+	defer span.End()
+
+	bs, e := ioutil.ReadAll(r.Body)
+	if nil != e {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bs))
 	decoder.UseNumber()
 	var ent map[string]interface{}
-	e := decoder.Decode(&ent)
+	e = decoder.Decode(&ent)
 	if nil != e {
 		w.WriteHeader(http.StatusBadRequest)
 		io.WriteString(w, e.Error())
 		return
 	}
 
+	injectTraceContextToEntity(ctx, ent)
 	job, e := createJobFromMap(backend, ent)
 	if nil != e {
+		if ve, ok := e.(*validationError); ok {
+			writeValidationError(w, ve)
+			return
+		}
 		w.WriteHeader(http.StatusInternalServerError)
 		io.WriteString(w, e.Error())
 		return
 	}
 
-	e = backend.create(job)
+	results, replayed, e := backend.pushJobs(r.Header.Get("Idempotency-Key"), bs, []*Job{job})
 	if nil != e {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(pushErrorStatus(e))
 		io.WriteString(w, e.Error())
 		return
 	}
-	w.WriteHeader(http.StatusOK)
-	io.WriteString(w, "OK")
-	return
+	writePushResult(w, replayed, results[0])
 }
 
 func pushAllHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
-	var jobs []*Job
-	decoder := json.NewDecoder(r.Body)
+	ctx, span := tracer.Start(extractTraceContextFromRequest(r), "pushAll")
+	defer span.End()
+
+	bs, e := ioutil.ReadAll(r.Body)
+	if nil != e {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bs))
 	decoder.UseNumber()
 	var entities []map[string]interface{}
-	e := decoder.Decode(&entities)
+	e = decoder.Decode(&entities)
 	if nil != e {
 		w.WriteHeader(http.StatusBadRequest)
 		io.WriteString(w, e.Error())
 		return
 	}
-	if nil == entities || 0 == len(entities) {
-		goto OK
-	}
 
-	jobs = make([]*Job, len(entities))
+	jobs := make([]*Job, len(entities))
 	for i, ent := range entities {
+		injectTraceContextToEntity(ctx, ent)
 		jobs[i], e = createJobFromMap(backend, ent)
 		if nil != e {
+			if ve, ok := e.(*validationError); ok {
+				writeValidationError(w, ve.withPrefix("data["+strconv.FormatInt(int64(i), 10)+"]"))
+				return
+			}
 			w.WriteHeader(http.StatusBadRequest)
 			io.WriteString(w, "parse data["+strconv.FormatInt(int64(i), 10)+"] failed, "+e.Error())
 			return
 		}
 	}
 
-	backend.create(jobs...)
+	results, replayed, e := backend.pushJobs(r.Header.Get("Idempotency-Key"), bs, jobs)
 	if nil != e {
-		w.WriteHeader(http.StatusInternalServerError)
+		w.WriteHeader(pushErrorStatus(e))
 		io.WriteString(w, e.Error())
 		return
 	}
-OK:
-	w.WriteHeader(http.StatusOK)
-	io.WriteString(w, "OK")
-	return
+	writePushResult(w, replayed, results)
+}
+
+func readSettingsFile() (map[string]interface{}, error) {
+	settings, e := readConfigFile(*config_file)
+	if nil != e {
+		if os.IsNotExist(e) {
+			return map[string]interface{}{}, nil
+		}
+		return nil, errors.New("read '" + *config_file + "' failed, " + e.Error())
+	}
+	return settings, nil
 }
 
 func readSettingsFileHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
-	fileHandler(w, r, *config_file, "{}")
+	settings, e := readSettingsFile()
+	if nil != e {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
+
+	w.Header()["Content-Type"] = []string{"application/json; charset=utf-8"}
+	e = json.NewEncoder(w).Encode(redactMap(settings))
+	if nil != e {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, e.Error())
+		return
+	}
 }
 
 func settingsFileHandler(w http.ResponseWriter, r *http.Request, backend *dbBackend) {
+	// 只能保存为 json 格式， yaml 和 toml 格式的文件要手工修改
+	if format := configFormat(*config_file); "json" != format {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, "config file '"+*config_file+"' is "+format+", it can't be modified by web ui.")
+		return
+	}
+
 	decoder := json.NewDecoder(r.Body)
 	decoder.UseNumber()
 	var entities map[string]interface{}
@@ -607,12 +754,30 @@ func settingsFileHandler(w http.ResponseWriter, r *http.Request, backend *dbBack
 		return
 	}
 
-	e = assignFlagSet("", entities, nil, default_actuals, false)
+	// 读取时密码等字段已被屏蔽， 未修改的字段要还原为原来的值
+	original, e := readSettingsFile()
+	if nil == e {
+		unredactMap(entities, original)
+	}
+
+	// 与从文件中加载一样展开 ${ENV}、 xxx_file 和 DELAYED_JOB_* 后检查， 有无效的配置时不保存
+	settings, _, e := resolveSettings(*config_file, entities, nil)
+	if nil != e {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, e.Error())
+		return
+	}
+	result, e := checkSettings("api", settings)
 	if nil != e {
 		w.WriteHeader(http.StatusBadRequest)
 		io.WriteString(w, e.Error())
 		return
 	}
+	if 0 != len(result.Rejected) {
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, rejectedText(result))
+		return
+	}
 
 	f, e := os.OpenFile(*config_file, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0666)
 	if nil != e {
@@ -634,20 +799,103 @@ func settingsFileHandler(w http.ResponseWriter, r *http.Request, backend *dbBack
 		io.WriteString(w, e.Error())
 		return
 	}
+	// 保存后与修改文件一样重新加载
+	result, e = reloadConfig("api")
+	if nil != e {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, "config is saved, but reload failed, "+e.Error())
+		return
+	}
+	backend.audit(r, "settings", 0, original, entities)
 
 	w.WriteHeader(http.StatusOK)
+	if 0 != len(result.Rejected) {
+		io.WriteString(w, "config is saved, but some are rejected:\r\n"+rejectedText(result))
+		return
+	}
+	if 0 != len(result.Restart) {
+		io.WriteString(w, "OK, "+strings.Join(result.Restart, ", ")+" take effect after restart")
+		return
+	}
 	io.WriteString(w, "OK")
 	return
 }
 
+func rejectedText(result *reloadResult) string {
+	var buffer bytes.Buffer
+	for _, r := range result.Rejected {
+		buffer.WriteString(r.Name)
+		buffer.WriteString(": ")
+		buffer.WriteString(r.Error)
+		buffer.WriteString("\r\n")
+	}
+	return buffer.String()
+}
+
 type webFront struct {
-	fs http.Handler
+	fs        http.Handler
+	auth      *authenticator
+	base_path string
 	*dbBackend
 }
 
+func newWebFront(backend *dbBackend, auth *authenticator) (*webFront, error) {
+	assets, e := assetsHandler()
+	if nil != e {
+		return nil, e
+	}
+	return &webFront{dbBackend: backend, fs: assets, auth: auth, base_path: normalizeBasePath(*base_path)}, nil
+}
+
 func (self *webFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 	backend := self.dbBackend
 
+	if "" != self.base_path && "/" != self.base_path && r.URL.Path+"/" == self.base_path {
+		http.Redirect(w, r, self.base_path, http.StatusMovedPermanently)
+		return
+	}
+	pa, ok := routePath(self.base_path, r.URL.Path)
+	if !ok {
+		http.NotFound(w, r)
+		return
+	}
+	if pa != r.URL.Path {
+		// 与 http.StripPrefix 一样， 复制请求后修改路径
+		r2 := new(http.Request)
+		*r2 = *r
+		r2.URL = new(url.URL)
+		*r2.URL = *r.URL
+		r2.URL.Path = pa
+		r2.URL.RawPath = ""
+		r = r2
+	}
+
+	if nil != self.auth {
+		p, e := self.auth.authorize(r)
+		if nil != e {
+			if isAPIv2(r.URL.Path) {
+				auditRequest(p, r, writeAPIAuthError(w, e))
+			} else {
+				auditRequest(p, r, writeAuthError(w, e))
+			}
+			return
+		}
+		r = r.WithContext(withPrincipal(r.Context(), p))
+	}
+
+	if "GET" != r.Method && "HEAD" != r.Method {
+		recorder := &statusRecorder{ResponseWriter: w}
+		w = recorder
+		defer func() {
+			auditRequest(principalFrom(r.Context()), r, recorder.status)
+		}()
+	}
+
+	if isAPIv2(r.URL.Path) {
+		apiV2Handler(w, r, backend)
+		return
+	}
+
 	switch r.Method {
 	case "GET":
 		switch r.URL.Path {
@@ -666,20 +914,37 @@ func (self *webFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		case "/counts":
 			countsHandler(w, r, backend)
 			return
-		case "/settings_file", "/delayed_jobs/settings_file", "/delayed_job/settings_file":
+		case "/metrics":
+			metricsHandler(w, r, backend)
+			return
+		case "/settings_file":
 			readSettingsFileHandler(w, r, backend)
 			return
+		case "/settings_file/reload":
+			lastReloadHandler(w, r, backend)
+			return
+		case "/audit":
+			auditHandler(w, r, backend)
+			return
+		case "/handlers":
+			handlersHandler(w, r, backend)
+			return
+		case "/events":
+			eventsHandler(w, r, backend)
+			return
+		case "/export":
+			exportHandler(w, r, backend)
+			return
 		default:
 			if !strings.HasPrefix(r.URL.Path, "/debug/") {
 				if nil == self.fs {
-					statikFS, err := fs.New()
+					assets, err := assetsFS("")
 					if err != nil {
 						w.WriteHeader(http.StatusInternalServerError)
 						io.WriteString(w, err.Error())
 						return
 					}
-					//http.Handle("/public/", http.StripPrefix("/public/", http.FileServer(statikFS)))
-					self.fs = http.StripPrefix("/", http.FileServer(statikFS))
+					self.fs = http.FileServer(http.FS(assets))
 				}
 				self.fs.ServeHTTP(w, r)
 				return
@@ -688,10 +953,14 @@ func (self *webFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	case "PUT":
 		switch r.URL.Path {
-		case "/test", "/delayed_jobs/test", "/delayed_job/test":
+		case "/test":
 			testJobHandler(w, r, backend)
 			return
 
+		case "/preview":
+			previewJobHandler(w, r, backend)
+			return
+
 		case "/push":
 			pushHandler(w, r, backend)
 			return
@@ -700,17 +969,21 @@ func (self *webFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			pushAllHandler(w, r, backend)
 			return
 
-		case "/settings_file", "/delayed_jobs/settings_file", "/delayed_job/settings_file":
+		case "/settings_file":
 			settingsFileHandler(w, r, backend)
 			return
 		}
 
 	case "POST":
 		switch r.URL.Path {
-		case "/test", "/delayed_jobs/test":
+		case "/test":
 			testJobHandler(w, r, backend)
 			return
 
+		case "/preview":
+			previewJobHandler(w, r, backend)
+			return
+
 		case "/push":
 			pushHandler(w, r, backend)
 			return
@@ -719,81 +992,88 @@ func (self *webFront) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			pushAllHandler(w, r, backend)
 			return
 
-		case "/settings_file", "/delayed_jobs/settings_file", "/delayed_job/settings_file":
+		case "/settings_file":
 			settingsFileHandler(w, r, backend)
 			return
-		}
 
-		for _, retry := range retry_list {
-			if retry.MatchString(r.URL.Path) {
-				ss := strings.Split(r.URL.Path, "/")
-				id, e := strconv.ParseInt(ss[len(ss)-2], 10, 0)
-				if nil != e {
-					w.WriteHeader(http.StatusBadRequest)
-					io.WriteString(w, e.Error())
-					return
-				}
+		case "/settings_file/reload":
+			reloadConfigHandler(w, r, backend)
+			return
 
-				e = backend.retry(id)
-				if nil == e {
-					w.WriteHeader(http.StatusOK)
-					io.WriteString(w, "The job has been queued for a re-run")
-				} else {
-					w.WriteHeader(http.StatusInternalServerError)
-					io.WriteString(w, e.Error())
-				}
+		case "/import":
+			importHandler(w, r, backend)
+			return
+		}
+
+		if retry_pattern.MatchString(r.URL.Path) {
+			ss := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+			id, e := strconv.ParseInt(ss[0], 10, 0)
+			if nil != e {
+				w.WriteHeader(http.StatusBadRequest)
+				io.WriteString(w, e.Error())
 				return
 			}
-		}
 
-		for _, job_id := range delete_by_id_list {
-			if job_id.MatchString(r.URL.Path) {
-				ss := strings.Split(r.URL.Path, "/")
-				id, e := strconv.ParseInt(ss[len(ss)-2], 10, 0)
-				if nil != e {
-					w.WriteHeader(http.StatusBadRequest)
-					io.WriteString(w, e.Error())
-					return
-				}
+			before := backend.jobSnapshot(id)
+			e = backend.retry(id)
+			if nil == e {
+				backend.audit(r, "retry", id, before, backend.jobSnapshot(id))
+				publishSnapshotEvent(event_retried, before)
+				w.WriteHeader(http.StatusOK)
+				io.WriteString(w, "The job has been queued for a re-run")
+			} else {
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, e.Error())
+			}
+			return
+		}
 
-				e = backend.destroy(id)
-				if nil == e {
-					w.WriteHeader(http.StatusOK)
-					io.WriteString(w, "The job was deleted")
-				} else {
-					w.WriteHeader(http.StatusInternalServerError)
-					io.WriteString(w, e.Error())
-				}
+		if delete_by_id_pattern.MatchString(r.URL.Path) {
+			ss := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+			id, e := strconv.ParseInt(ss[0], 10, 0)
+			if nil != e {
+				w.WriteHeader(http.StatusBadRequest)
+				io.WriteString(w, e.Error())
 				return
 			}
+
+			before := backend.jobSnapshot(id)
+			e = backend.destroy(id)
+			if nil == e {
+				backend.audit(r, "delete", id, before, nil)
+				publishSnapshotEvent(event_deleted, before)
+				w.WriteHeader(http.StatusOK)
+				io.WriteString(w, "The job was deleted")
+			} else {
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, e.Error())
+			}
+			return
 		}
 	case "DELETE":
-		for _, job_id := range job_id_list {
-			if job_id.MatchString(r.URL.Path) {
-				ss := strings.Split(r.URL.Path, "/")
-				id, e := strconv.ParseInt(ss[len(ss)-1], 10, 0)
-				if nil != e {
-					w.WriteHeader(http.StatusBadRequest)
-					io.WriteString(w, e.Error())
-					return
-				}
-
-				e = backend.destroy(id)
-				if nil == e {
-					w.WriteHeader(http.StatusOK)
-					io.WriteString(w, "The job was deleted")
-				} else {
-					w.WriteHeader(http.StatusInternalServerError)
-					io.WriteString(w, e.Error())
-				}
+		if job_id_pattern.MatchString(r.URL.Path) {
+			ss := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+			id, e := strconv.ParseInt(ss[0], 10, 0)
+			if nil != e {
+				w.WriteHeader(http.StatusBadRequest)
+				io.WriteString(w, e.Error())
 				return
 			}
+
+			before := backend.jobSnapshot(id)
+			e = backend.destroy(id)
+			if nil == e {
+				backend.audit(r, "delete", id, before, nil)
+				publishSnapshotEvent(event_deleted, before)
+				w.WriteHeader(http.StatusOK)
+				io.WriteString(w, "The job was deleted")
+			} else {
+				w.WriteHeader(http.StatusInternalServerError)
+				io.WriteString(w, e.Error())
+			}
+			return
 		}
 	}
 
 	http.DefaultServeMux.ServeHTTP(w, r)
 }
-
-func httpServe(backend *dbBackend, handler http.Handler, runHttp func(http.Handler)) {
-	runHttp(&webFront{dbBackend: backend, fs: handler})
-}
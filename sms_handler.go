@@ -2,10 +2,11 @@ package delayed_job
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
-	"log"
+	"log/slog"
 	"net"
 	"os/exec"
 	"path/filepath"
@@ -20,7 +21,6 @@ import (
 	"github.com/runner-mei/delayed_job/ns20"
 )
 
-var smsLogger *log.Logger
 var gammu_config string
 var gammu string
 var gammu_with_smsd = flag.Bool("with_smsd", false, "send sms by smsd")
@@ -46,10 +46,6 @@ var smsf405Charset string
 
 var GetAliyunClientParams func() (accessKey, secretKey, signName, templateCode string)
 
-func SetSMSLogger(logger *log.Logger) {
-	smsLogger = logger
-}
-
 func init() {
 	if runtime.GOOS == "windows" {
 		flag.StringVar(&gammu_config, "gammu_config", "data/conf/gammu.conf", "the path of gaummu")
@@ -119,7 +115,7 @@ func readPhoneNumbers(params map[string]interface{}) ([]string, error) {
 			}
 
 			if GetUserPhone == nil {
-				log.Println("GetUserPhone hook is empty")
+				logger.Warn("GetUserPhone hook is empty")
 				continue
 			}
 			phone, err := GetUserPhone(id)
@@ -127,7 +123,7 @@ func readPhoneNumbers(params map[string]interface{}) ([]string, error) {
 				return nil, err
 			}
 			if phone == "" {
-				log.Println("phone is missing for user", id)
+				logger.Warn("phone is missing for user", "user", id)
 			} else {
 				phoneNumbers = append(phoneNumbers, phone)
 				// log.Println("phone is '", phone, "' for user", id)
@@ -225,16 +221,17 @@ func readStringWith(o interface{}, key, defaultValue string) string {
 }
 
 func (self *smsHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *smsHandler) PerformContext(ctx context.Context) error {
 	if IsDevEnv {
 		return ErrDevEnv
 	}
+	l := loggerFrom(ctx).With(slog.String("handler", "sms"))
 	if smsLimiter != nil {
 		if !smsLimiter.CanSend() {
-			log.Println("超过限制不能再发了")
-
-			if smsLogger != nil {
-				smsLogger.Println("[sms] 超过限制不能再发了", self.phone_numbers)
-			}
+			l.Warn("超过限制不能再发了", "phone_numbers", self.phone_numbers)
 			return nil
 		}
 	}
@@ -295,9 +292,7 @@ func (self *smsHandler) Perform() error {
 			continue
 		}
 
-		if smsLogger != nil {
-			smsLogger.Println("[sms]", "["+self.method+"]", phone, self.content)
-		}
+		l.Info("sms sent", "method", self.method, "phone", phone, "content", self.content)
 		if smsLimiter != nil {
 			smsLimiter.Add(1)
 		}
@@ -342,7 +337,7 @@ func SendByGammu(phone, content string) error {
 		return errors.New(string(output))
 	}
 
-	fmt.Println("[sms]", phone, content, string(output))
+	logger.Info("sms sent by gammu", "phone", phone, "output", string(output))
 	return nil
 }
 
@@ -378,7 +373,7 @@ func SendByMuboatv1(phone, content string) error {
 	}
 	defer conn.Close()
 
-	log.Println("sms connect ok")
+	logger.Debug("sms connect ok")
 
 	return muboat.SendMessage(conn, false, true, true, time.Duration(smsMuboatV1Timeout)*time.Second, phone, content)
 }
@@ -470,8 +465,22 @@ func SendByAliyun(phone, content string) error {
 	return err
 }
 
+const sms_schema = `{
+  "description": "send a sms by gammu",
+  "type": "object",
+  "required": ["content"],
+  "properties": {
+    "phone_numbers": {"type": ["string", "array"]},
+    "users": {"type": ["string", "array"]},
+    "content": {"type": "string", "minLength": 1},
+    "arguments": {"description": "the values of the templates"}
+  },
+  "x-aliases": {"phone_numbers": ["phoneNumbers"]}
+}`
+
 func init() {
 	Handlers["sms"] = newSMSHandler
 	Handlers["sms_action"] = newSMSHandler
 	Handlers["sms_command"] = newSMSHandler
+	RegisterHandlerSchema(sms_schema, "sms", "sms_action", "sms_command")
 }
@@ -2,15 +2,40 @@ package delayed_job
 
 import (
 	"encoding/json"
+	"errors"
+	"flag"
 	"io/ioutil"
-	"log"
 	"os"
+	"strconv"
 	"sync"
 	"time"
 )
 
 var smsLimiter *SmsLimiter
 
+var (
+	sms_limiter_day   = flag.Int("sms.limiter.day", -1, "the max number of sms sent in a day, 0 is unlimited, -1 is the limit given to NewSMSLimiter")
+	sms_limiter_week  = flag.Int("sms.limiter.week", -1, "the max number of sms sent in a week, 0 is unlimited, -1 is the limit given to NewSMSLimiter")
+	sms_limiter_month = flag.Int("sms.limiter.month", -1, "the max number of sms sent in a month, 0 is unlimited, -1 is the limit given to NewSMSLimiter")
+)
+
+func init() {
+	onConfigChanged([]string{"sms.limiter.*"}, func(values map[string]interface{}) error {
+		day, _ := values["sms.limiter.day"].(int)
+		week, _ := values["sms.limiter.week"].(int)
+		month, _ := values["sms.limiter.month"].(int)
+		for _, limit := range []int{day, week, month} {
+			if limit < -1 {
+				return errors.New("the limit of sms must is geater(or equals) -1, actual value is " + strconv.Itoa(limit))
+			}
+		}
+		if nil != smsLimiter {
+			smsLimiter.SetLimits(int32(day), int32(week), int32(month))
+		}
+		return nil
+	})
+}
+
 type smsdata struct {
 	TS    int32 `json:"ts"`
 	Count int32 `json`
@@ -73,6 +98,49 @@ func (smsLimiter *SmsLimiter) CanSend() bool {
 	return true
 }
 
+// Counts 返回当天，最近一周和最近一个月已发送的短信数
+func (smsLimiter *SmsLimiter) Counts() (day, week, month int32) {
+	smsLimiter.mu.Lock()
+	defer smsLimiter.mu.Unlock()
+
+	ts := time.Now()
+	today := int32(ts.Year())*10000 + int32(ts.YearDay())
+	for idx := range smsLimiter.data {
+		if smsLimiter.data[idx].TS == today {
+			day = smsLimiter.data[idx].Count
+			break
+		}
+	}
+	return day, smsLimiter.countByRange(today, 7), smsLimiter.countByRange(today, 30)
+}
+
+// Limits 返回每天，每周和每月的限额， 0 表示不限制
+func (smsLimiter *SmsLimiter) Limits() (day, week, month int32) {
+	smsLimiter.mu.Lock()
+	defer smsLimiter.mu.Unlock()
+	return smsLimiter.dayLimit, smsLimiter.weekLimit, smsLimiter.monthLimit
+}
+
+// SetLimits 修改限额， 小于 0 的值表示不修改
+func (smsLimiter *SmsLimiter) SetLimits(day, week, month int32) {
+	smsLimiter.mu.Lock()
+	defer smsLimiter.mu.Unlock()
+	if day >= 0 {
+		smsLimiter.dayLimit = day
+	}
+	if week >= 0 {
+		smsLimiter.weekLimit = week
+	}
+	if month >= 0 {
+		smsLimiter.monthLimit = month
+	}
+}
+
+// applyFlags 使用 sms.limiter.* 中指定的限额
+func (smsLimiter *SmsLimiter) applyFlags() {
+	smsLimiter.SetLimits(int32(configInt("sms.limiter.day")), int32(configInt("sms.limiter.week")), int32(configInt("sms.limiter.month")))
+}
+
 func (smsLimiter *SmsLimiter) Add(count int) {
 	smsLimiter.mu.Lock()
 	defer smsLimiter.mu.Unlock()
@@ -118,11 +186,12 @@ func NewSMSLimiter(filename string, dayLimit, weekLimit, monthLimit int32) (*Sms
 	} else {
 		err = json.Unmarshal(bs, &limiter.data)
 		if err != nil {
-			log.Println("载入 sms limiter 失败", err)
+			logger.Error("载入 sms limiter 失败", "error", err)
 		} else if len(limiter.data) > 365 {
 			limiter.data = limiter.data[:365]
 		}
 	}
+	limiter.applyFlags()
 	return limiter, nil
 }
 
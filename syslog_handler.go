@@ -2,9 +2,11 @@ package delayed_job
 
 import (
 	"bytes"
+	"context"
 	"errors"
 	"flag"
 	"fmt"
+	"log/slog"
 	"net"
 	"os"
 	"strings"
@@ -240,6 +242,11 @@ func newSyslogHandler(ctx, params map[string]interface{}) (Handler, error) {
 }
 
 func (self *syslogHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *syslogHandler) PerformContext(ctx context.Context) error {
+	l := loggerFrom(ctx).With(slog.String("handler", "syslog"))
 	if IsDevEnv {
 		return ErrDevEnv
 	}
@@ -247,7 +254,7 @@ func (self *syslogHandler) Perform() error {
 	buf := bytes.NewBuffer(make([]byte, 0, 1000))
 	hasOk := false
 	for _, to := range self.to {
-		e := self.send(to)
+		e := self.send(l, to)
 		if nil == e {
 			hasOk = true
 		} else {
@@ -265,19 +272,44 @@ func (self *syslogHandler) Perform() error {
 	return errors.New(buf.String())
 }
 
-func (self *syslogHandler) send(to *net.UDPAddr) error {
+func (self *syslogHandler) send(l *slog.Logger, to *net.UDPAddr) error {
 	c, e := net.DialUDP("udp", nil, to)
 	if nil != e {
 		return e
 	}
 	defer c.Close()
 
-	fmt.Println(c.RemoteAddr(), self.message)
+	l.Info("send syslog", "to", c.RemoteAddr().String(), "message", self.message)
 	_, e = c.Write([]byte(self.message))
 	return e
 }
 
+func (self *syslogHandler) Preview() (map[string]interface{}, error) {
+	to := make([]string, 0, len(self.to))
+	for _, addr := range self.to {
+		to = append(to, addr.String())
+	}
+	return map[string]interface{}{"to": to, "message": self.message}, nil
+}
+
+const syslog_schema = `{
+  "description": "send a syslog message by udp",
+  "type": "object",
+  "required": ["to_address", "content"],
+  "properties": {
+    "to_address": {"type": ["string", "array"], "description": "the addresses separated by ',', the default port is 514"},
+    "facility": {"type": "string"},
+    "severity": {"type": "string"},
+    "hostname": {"type": "string"},
+    "tag": {"type": "string"},
+    "timestamp": {"description": "the time of the message, default is now"},
+    "content": {"type": "string", "minLength": 1},
+    "arguments": {"description": "the values of the templates"}
+  }
+}`
+
 func init() {
 	Handlers["syslog"] = newSyslogHandler
 	Handlers["syslog_command"] = newSyslogHandler
+	RegisterHandlerSchema(syslog_schema, "syslog", "syslog_command")
 }
@@ -0,0 +1,56 @@
+package delayed_job
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+)
+
+var (
+	http_tls_cert  = flag.String("http.tls_cert", "", "the certificate file of https, http is used if it is empty")
+	http_tls_key   = flag.String("http.tls_key", "", "the private key file of https")
+	http_client_ca = flag.String("http.client_ca", "", "the ca file which verifies the client certificates, it is required by auth.mtls_users and the 'mtls:' credentials")
+)
+
+// newTLSConfig 返回 https 的配置， 指定了 http.client_ca 时校验客户端的证书， 没有证书的客户端可以使用其它的认证方式
+func newTLSConfig() (*tls.Config, error) {
+	config := &tls.Config{MinVersion: tls.VersionTLS12}
+	if "" == *http_client_ca {
+		return config, nil
+	}
+
+	bs, e := os.ReadFile(*http_client_ca)
+	if nil != e {
+		return nil, errors.New("read http.client_ca failed, " + e.Error())
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(bs) {
+		return nil, errors.New("http.client_ca '" + *http_client_ca + "' hasn't any certificate")
+	}
+	config.ClientCAs = pool
+	config.ClientAuth = tls.VerifyClientCertIfGiven
+	return config, nil
+}
+
+// ListenAndServe 启动 http 服务， 指定了 http.tls_cert 和 http.tls_key 时使用 https
+func ListenAndServe(addr string, handler http.Handler) error {
+	if "" == *http_tls_cert && "" == *http_tls_key {
+		if "" != *http_client_ca {
+			return errors.New("http.client_ca requires http.tls_cert and http.tls_key")
+		}
+		return http.ListenAndServe(addr, handler)
+	}
+	if "" == *http_tls_cert || "" == *http_tls_key {
+		return errors.New("http.tls_cert and http.tls_key must be given together")
+	}
+
+	config, e := newTLSConfig()
+	if nil != e {
+		return e
+	}
+	srv := &http.Server{Addr: addr, Handler: handler, TLSConfig: config}
+	return srv.ListenAndServeTLS(*http_tls_cert, *http_tls_key)
+}
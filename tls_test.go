@@ -0,0 +1,126 @@
+package delayed_job
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func newTestCert(t *testing.T, cn string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, e := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if nil != e {
+		t.Fatal(e)
+	}
+	template := &x509.Certificate{SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:     pkix.Name{CommonName: cn},
+		NotBefore:   time.Now().Add(-time.Hour),
+		NotAfter:    time.Now().Add(time.Hour),
+		KeyUsage:    x509.KeyUsageDigitalSignature,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth}}
+	if nil == parent {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage |= x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	bs, e := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	if nil != e {
+		t.Fatal(e)
+	}
+	cert, e := x509.ParseCertificate(bs)
+	if nil != e {
+		t.Fatal(e)
+	}
+	return cert, key
+}
+
+func TestTLSClientCertificate(t *testing.T) {
+	ca, caKey := newTestCert(t, "test_ca", nil, nil)
+	client, clientKey := newTestCert(t, "client1", ca, caKey)
+
+	dir, e := ioutil.TempDir("", "delayed_job_tls")
+	if nil != e {
+		t.Fatal(e)
+	}
+	defer os.RemoveAll(dir)
+	caFile := filepath.Join(dir, "ca.pem")
+	if e := ioutil.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}), 0600); nil != e {
+		t.Fatal(e)
+	}
+
+	old := *http_client_ca
+	*http_client_ca = caFile
+	defer func() { *http_client_ca = old }()
+
+	config, e := newTLSConfig()
+	if nil != e {
+		t.Fatal(e)
+	}
+
+	auth := &authenticator{users: map[string]credential{}, mtls_users: map[string]credential{}}
+	if e := auth.addList("mtls", "client1:admin"); nil != e {
+		t.Fatal(e)
+	}
+	srv := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p, e := auth.authenticate(r)
+		if nil != e {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		io.WriteString(w, p.name+":"+roleString(p.role))
+	}))
+	srv.TLS = config
+	srv.StartTLS()
+	defer srv.Close()
+
+	get := func(certs ...tls.Certificate) (int, string) {
+		transport := srv.Client().Transport.(*http.Transport).Clone()
+		transport.TLSClientConfig.Certificates = certs
+		res, e := (&http.Client{Transport: transport}).Get(srv.URL)
+		if nil != e {
+			t.Fatal(e)
+		}
+		defer res.Body.Close()
+		bs, _ := ioutil.ReadAll(res.Body)
+		return res.StatusCode, string(bs)
+	}
+
+	status, body := get(tls.Certificate{Certificate: [][]byte{client.Raw}, PrivateKey: clientKey})
+	if http.StatusOK != status || "client1:admin" != body {
+		t.Error("excepted is 200 client1:admin, actual is", status, body)
+	}
+
+	// 没有证书的客户端也可以连接， 只是不能通过 mtls 认证
+	status, _ = get()
+	if http.StatusUnauthorized != status {
+		t.Error("excepted is 401, actual is", status)
+	}
+}
+
+func TestListenAndServeInvalidTLS(t *testing.T) {
+	oldCert, oldKey, oldCA := *http_tls_cert, *http_tls_key, *http_client_ca
+	defer func() { *http_tls_cert, *http_tls_key, *http_client_ca = oldCert, oldKey, oldCA }()
+
+	*http_tls_cert, *http_tls_key, *http_client_ca = "a.pem", "", ""
+	if e := ListenAndServe("127.0.0.1:0", http.NotFoundHandler()); nil == e {
+		t.Error("excepted error when http.tls_key is empty")
+	}
+
+	*http_tls_cert, *http_tls_key, *http_client_ca = "", "", "ca.pem"
+	if e := ListenAndServe("127.0.0.1:0", http.NotFoundHandler()); nil == e {
+		t.Error("excepted error when http.client_ca is given without http.tls_cert")
+	}
+}
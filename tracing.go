@@ -0,0 +1,155 @@
+package delayed_job
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// 跟踪上下文保存在任务的 handler 中的键名
+	traceparent_key = "_traceparent"
+	tracestate_key  = "_tracestate"
+)
+
+var (
+	tracing_exporter      = flag.String("tracing.exporter", "", "the exporter of tracing, 'otlp' or 'file', tracing is disabled if it is empty")
+	tracing_otlp_endpoint = flag.String("tracing.otlp.endpoint", "127.0.0.1:4318", "the address of otlp/http collector")
+	tracing_otlp_insecure = flag.Bool("tracing.otlp.insecure", true, "disable tls for otlp/http collector")
+	tracing_file          = flag.String("tracing.file", "delayed_job_traces.json", "the file name of file exporter")
+	tracing_service_name  = flag.String("tracing.service_name", "delayed_job", "the service name in the tracing")
+
+	tracer           = otel.Tracer("github.com/runner-mei/delayed_job")
+	trace_propagator = propagation.TraceContext{}
+)
+
+// ContextHandler 是一个可选接口，实现它的 Handler 在执行时会收到包含跟踪信息的 ctx
+type ContextHandler interface {
+	PerformContext(ctx context.Context) error
+}
+
+func initTracing() (func(), error) {
+	var exporter sdktrace.SpanExporter
+	var closer func()
+
+	switch *tracing_exporter {
+	case "":
+		return func() {}, nil
+	case "otlp":
+		opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(*tracing_otlp_endpoint)}
+		if *tracing_otlp_insecure {
+			opts = append(opts, otlptracehttp.WithInsecure())
+		}
+		var e error
+		exporter, e = otlptracehttp.New(context.Background(), opts...)
+		if nil != e {
+			return nil, errors.New("create otlp exporter failed, " + e.Error())
+		}
+		closer = func() {}
+	case "file":
+		f, e := os.OpenFile(*tracing_file, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0666)
+		if nil != e {
+			return nil, errors.New("open tracing file failed, " + e.Error())
+		}
+		exporter, e = stdouttrace.New(stdouttrace.WithWriter(f))
+		if nil != e {
+			f.Close()
+			return nil, errors.New("create file exporter failed, " + e.Error())
+		}
+		closer = func() { f.Close() }
+	default:
+		return nil, errors.New("tracing exporter '" + *tracing_exporter + "' is unsupported")
+	}
+
+	provider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(resource.NewWithAttributes("",
+			attribute.String("service.name", *tracing_service_name))))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(trace_propagator)
+
+	return func() {
+		provider.Shutdown(context.Background())
+		closer()
+	}, nil
+}
+
+// extractTraceContextFromRequest 读取请求中 W3C traceparent 头
+func extractTraceContextFromRequest(r *http.Request) context.Context {
+	return trace_propagator.Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+}
+
+// injectTraceContext 将 ctx 中的跟踪信息保存到任务的 handler 中
+func injectTraceContext(ctx context.Context, attributes map[string]interface{}) {
+	if nil == attributes {
+		return
+	}
+	carrier := propagation.MapCarrier{}
+	trace_propagator.Inject(ctx, carrier)
+	if s := carrier.Get("traceparent"); "" != s {
+		attributes[traceparent_key] = s
+	}
+	if s := carrier.Get("tracestate"); "" != s {
+		attributes[tracestate_key] = s
+	}
+}
+
+// injectTraceContextToEntity 将跟踪信息保存到 push 请求中的任务的 handler 中
+func injectTraceContextToEntity(ctx context.Context, ent map[string]interface{}) {
+	if handler, ok := ent["handler"].(map[string]interface{}); ok {
+		injectTraceContext(ctx, handler)
+	}
+}
+
+// extractTraceContext 从任务的 handler 中恢复跟踪信息
+func extractTraceContext(ctx context.Context, attributes map[string]interface{}) context.Context {
+	if nil == attributes {
+		return ctx
+	}
+	carrier := propagation.MapCarrier{}
+	if s := stringWithDefault(attributes, traceparent_key, ""); "" != s {
+		carrier.Set("traceparent", s)
+	}
+	if s := stringWithDefault(attributes, tracestate_key, ""); "" != s {
+		carrier.Set("tracestate", s)
+	}
+	if 0 == len(carrier) {
+		return ctx
+	}
+	return trace_propagator.Extract(ctx, carrier)
+}
+
+// traceHeaders 返回用于向下游传递跟踪信息的头
+func traceHeaders(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	trace_propagator.Inject(ctx, carrier)
+	return carrier
+}
+
+func jobSpanAttributes(job *Job) trace.SpanStartOption {
+	return trace.WithAttributes(attribute.Int64("job.id", job.id),
+		attribute.String("job.handler_id", job.handler_id),
+		attribute.String("job.type", job.handlerType()),
+		attribute.String("job.queue", job.queue),
+		attribute.Int("job.attempts", job.attempts))
+}
+
+func endSpan(span trace.Span, e error) {
+	if nil != e {
+		span.RecordError(e)
+		span.SetStatus(codes.Error, strings.SplitN(e.Error(), "\n", 2)[0])
+	}
+	span.End()
+}
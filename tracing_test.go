@@ -0,0 +1,46 @@
+package delayed_job
+
+import (
+	"context"
+	"testing"
+
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestTraceContextRoundTrip(t *testing.T) {
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+		Remote:     true,
+	}))
+
+	handler := map[string]interface{}{"type": "test"}
+	injectTraceContext(ctx, handler)
+	if excepted := "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01"; excepted != handler[traceparent_key] {
+		t.Error("excepted traceparent is", excepted, ", actual is", handler[traceparent_key])
+	}
+
+	sc := trace.SpanContextFromContext(extractTraceContext(context.Background(), handler))
+	if sc.TraceID() != traceID {
+		t.Error("excepted trace id is", traceID, ", actual is", sc.TraceID())
+	}
+	if sc.SpanID() != spanID {
+		t.Error("excepted span id is", spanID, ", actual is", sc.SpanID())
+	}
+}
+
+func TestTraceContextWithoutParent(t *testing.T) {
+	handler := map[string]interface{}{"type": "test"}
+	injectTraceContext(context.Background(), handler)
+	if _, ok := handler[traceparent_key]; ok {
+		t.Error("traceparent should not be saved without a span")
+	}
+
+	ctx := context.Background()
+	if extractTraceContext(ctx, handler) != ctx {
+		t.Error("ctx should be unchanged without a traceparent")
+	}
+}
@@ -161,7 +161,7 @@ func asTimeWithDefault(v interface{}, defaultValue time.Time) time.Time {
 			time.RFC1123Z,
 			time.RFC3339,
 			time.RFC3339Nano} {
-			t, e := time.Parse(value, layout)
+			t, e := time.Parse(layout, value)
 			if nil == e {
 				return t
 			}
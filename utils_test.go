@@ -3,8 +3,19 @@ package delayed_job
 import (
 	"regexp"
 	"testing"
+	"time"
 )
 
+func TestAsTimeWithDefault(t *testing.T) {
+	now := time.Now()
+	if actual := asTimeWithDefault("2020-01-02T03:04:05Z", now); 2020 != actual.Year() || 5 != actual.Second() {
+		t.Error("excepted is 2020-01-02T03:04:05Z, actual is", actual)
+	}
+	if actual := asTimeWithDefault("abc", now); !actual.Equal(now) {
+		t.Error("excepted is", now, ", actual is", actual)
+	}
+}
+
 // TestReplaceIPs 测试IP替换功能
 func TestReplaceIPs(t *testing.T) {
 	tests := []struct {
@@ -2,6 +2,7 @@ package delayed_job
 
 import (
 	"bytes"
+	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/md5"
@@ -12,7 +13,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
-	"log"
+	"log/slog"
 	"mime/multipart"
 	"net/http"
 	"net/url"
@@ -22,6 +23,8 @@ import (
 	"text/template"
 	"time"
 
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/text/transform"
 )
 
@@ -274,18 +277,17 @@ func newWebHandler(ctx, params map[string]interface{}) (Handler, error) {
 	}, nil
 }
 
-func (self *webHandler) logRequest(body interface{}, response ...[]byte) {
-	log.Println("method=", self.method)
-	log.Println("url=", self.urlStr)
-	log.Println("headers=", self.headers)
-	log.Println("password=", self.password)
-	log.Println("contentType=", self.contentType)
-	log.Println("body=", body)
-	log.Println("user=", self.user)
-	log.Println("phoneNumbers=", self.phoneNumbers)
-	log.Println("supportBatch=", self.supportBatch)
+func (self *webHandler) logRequest(l *slog.Logger, body interface{}, response ...[]byte) {
+	args := []interface{}{"method", self.method,
+		"url", redactText(self.urlStr),
+		"headers", redactMap(self.headers),
+		"contentType", self.contentType,
+		"body", redactValue(body),
+		"user", self.user,
+		"phoneNumbers", self.phoneNumbers,
+		"supportBatch", self.supportBatch}
 	if len(response) > 0 {
-		log.Println("response=", string(response[0]))
+		args = append(args, "response", string(response[0]))
 
 		responseBytes := response[0]
 		if bytes.HasPrefix(responseBytes, []byte("info=")) {
@@ -294,9 +296,10 @@ func (self *webHandler) logRequest(body interface{}, response ...[]byte) {
 		dst := make([]byte, len(responseBytes))
 		n, err := base64.StdEncoding.Decode(dst, responseBytes)
 		if err == nil {
-			log.Println("response decoded=", string(dst[:n]))
+			args = append(args, "response_decoded", string(dst[:n]))
 		}
 	}
+	l.Info("web request", args...)
 }
 
 func (self *webHandler) UpdatePayloadObject(options map[string]interface{}) {
@@ -311,10 +314,18 @@ func (self *webHandler) UpdatePayloadObject(options map[string]interface{}) {
 }
 
 func (self *webHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *webHandler) PerformContext(ctx context.Context) error {
 	if IsDevEnv {
 		return ErrDevEnv
 	}
+	return self.forEachRequest(ctx, self.perform)
+}
 
+// forEachRequest 生成每个请求的 body 并交给 send 处理， 按手机号逐个发送的短信会有多个请求
+func (self *webHandler) forEachRequest(ctx context.Context, send func(ctx context.Context, body interface{}) error) error {
 	if !self.isWebSMS {
 		var body interface{}
 		if self.method != "GET" && self.method != "HEAD" {
@@ -327,7 +338,7 @@ func (self *webHandler) Perform() error {
 			}
 		}
 
-		return self.perform(body)
+		return send(ctx, body)
 	} else if self.supportBatch {
 		var body interface{}
 		if self.method != "GET" && self.method != "HEAD" {
@@ -342,7 +353,7 @@ func (self *webHandler) Perform() error {
 			}
 		}
 
-		return self.perform(body)
+		return send(ctx, body)
 	}
 	self.failedPhoneNumbers = self.phoneNumbers
 
@@ -361,7 +372,7 @@ func (self *webHandler) Perform() error {
 				body = value
 			}
 		}
-		err := self.perform(body)
+		err := send(ctx, body)
 		if err != nil {
 			failed = append(failed, phone)
 			lastErr = err
@@ -371,7 +382,29 @@ func (self *webHandler) Perform() error {
 	return lastErr
 }
 
-func (self *webHandler) perform(body interface{}) error {
+// Preview 返回将要发送的请求， 按手机号逐个发送的短信会有多个请求
+func (self *webHandler) Preview() (map[string]interface{}, error) {
+	var requests []interface{}
+	e := self.forEachRequest(context.Background(), func(ctx context.Context, body interface{}) error {
+		req, e := self.newRequest(ctx, body)
+		if e != nil {
+			return e
+		}
+		preview, e := previewHTTPRequest(req)
+		if e != nil {
+			return e
+		}
+		requests = append(requests, preview)
+		return nil
+	})
+	if e != nil {
+		return nil, e
+	}
+	return map[string]interface{}{"requests": requests}, nil
+}
+
+// newRequest 生成要发送的请求， 预览时也用它来渲染请求的内容
+func (self *webHandler) newRequest(ctx context.Context, body interface{}) (*http.Request, error) {
 	var reader io.Reader
 	if self.method != "GET" && self.method != "HEAD" {
 		if body != nil {
@@ -402,7 +435,7 @@ func (self *webHandler) perform(body interface{}) error {
 					buffer := bytes.NewBuffer(make([]byte, 0, 1024))
 					e := json.NewEncoder(buffer).Encode(body)
 					if nil != e {
-						return e
+						return nil, e
 					}
 					reader = buffer
 				}
@@ -410,9 +443,9 @@ func (self *webHandler) perform(body interface{}) error {
 		}
 	}
 
-	req, e := http.NewRequest(self.method, self.urlStr, reader)
+	req, e := http.NewRequestWithContext(ctx, self.method, self.urlStr, reader)
 	if e != nil {
-		return e
+		return nil, e
 	}
 	if "" != self.user {
 		req.URL.User = url.UserPassword(self.user, self.password)
@@ -425,11 +458,27 @@ func (self *webHandler) perform(body interface{}) error {
 			req.Header.Set(k, fmt.Sprint(v))
 		}
 	}
+	return req, nil
+}
+
+func (self *webHandler) perform(ctx context.Context, body interface{}) (e error) {
+	ctx, span := tracer.Start(ctx, "web "+self.method, trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("http.method", self.method)))
+	defer func() { endSpan(span, e) }()
+	l := loggerFrom(ctx).With(slog.String("handler", "web"))
+
+	req, e := self.newRequest(ctx, body)
+	if e != nil {
+		return e
+	}
+	for k, v := range traceHeaders(ctx) {
+		req.Header.Set(k, v)
+	}
 
-	log.Println("execute web:", self.method, self.urlStr)
+	l.Info("execute web", "method", self.method, "url", self.urlStr)
 	resp, e := http.DefaultClient.Do(req)
 	if nil != e {
-		self.logRequest(body)
+		self.logRequest(l, body)
 		return e
 	}
 
@@ -458,7 +507,7 @@ func (self *webHandler) perform(body interface{}) error {
 	}
 
 	if !ok {
-		self.logRequest(body)
+		self.logRequest(l, body)
 
 		respBody, err := ioutil.ReadAll(resp.Body)
 		if err != nil {
@@ -473,32 +522,32 @@ func (self *webHandler) perform(body interface{}) error {
 	}
 	if "" == self.responseContent {
 		respBody, _ := ioutil.ReadAll(resp.Body)
-		log.Printf("response is %s", respBody)
-		self.logRequest(body)
+		l.Info("web response", "response", string(respBody))
+		self.logRequest(l, body)
 		return nil
 	}
 
 	if resp.ContentLength < 1024*1024 {
 		respBody, err := ioutil.ReadAll(resp.Body)
 		if 0 == len(respBody) {
-			self.logRequest(body)
+			self.logRequest(l, body)
 			return fmt.Errorf("failed to read body - %s", err)
 		}
 
 		if bytes.Contains(respBody, []byte(self.responseContent)) {
 			return nil
 		}
-		self.logRequest(body, respBody)
+		self.logRequest(l, body, respBody)
 		return errors.New("'" + self.responseContent + "' isn't exists in the response body:\r\n" + string(respBody))
 	}
 
 	matched, e := IsContains(resp.Body, self.responseContent)
 	if nil != e {
-		self.logRequest(body)
+		self.logRequest(l, body)
 		return errors.New("failed to read body - " + e.Error())
 	}
 	if !matched {
-		self.logRequest(body)
+		self.logRequest(l, body)
 		return errors.New("'" + self.responseContent + "' isn't exists in the response body.")
 	}
 	return nil
@@ -537,6 +586,36 @@ func IsContains(r io.Reader, excepted string) (bool, error) {
 	return false, nil
 }
 
+const web_schema = `{
+  "description": "send a http request, or a sms by the web api if websms_type is set",
+  "type": "object",
+  "properties": {
+    "method": {"type": "string", "enum": ["GET", "PUT", "POST", "DELETE", "TRACE", "HEAD", "OPTIONS", "CONNECT", "PATCH"], "x-enum-ignore-case": true},
+    "url": {"type": "string", "minLength": 1},
+    "content_type": {"type": "string"},
+    "body": {"description": "a string is sent as is, others are encoded by content_type"},
+    "headers": {"type": ["string", "object"], "description": "one 'key=value' per line, or use 'head.<key>' fields"},
+    "username": {"type": "string"},
+    "password": {"type": "string"},
+    "response_code": {"type": ["integer", "string"]},
+    "response_content": {"type": "string"},
+    "websms_type": {"type": "string"},
+    "phone_numbers": {"type": ["string", "array"]},
+    "arguments": {"description": "the values of the templates"}
+  },
+  "anyOf": [{"required": ["url"]}, {"required": ["websms_type"]}],
+  "x-anyOf-message": "url or websms_type is required",
+  "x-aliases": {
+    "content_type": ["contentType"],
+    "headers": ["header"],
+    "response_code": ["responseCode"],
+    "response_content": ["responseContent"],
+    "username": ["user_name", "userName"],
+    "password": ["user_password", "userPassword"],
+    "phone_numbers": ["phoneNumbers"]
+  }
+}`
+
 func init() {
 	Handlers["web"] = newWebHandler
 	Handlers["websms"] = newWebHandler
@@ -547,6 +626,7 @@ func init() {
 	Handlers["http_action"] = newWebHandler
 	Handlers["http_command"] = newWebHandler
 	Handlers["itsm_command"] = newWebHandler
+	RegisterHandlerSchema(web_schema, "web", "websms", "websms_command", "web_action", "web_command", "http", "http_action", "http_command")
 }
 
 func parseInterval(s string, defValue time.Duration) time.Duration {
@@ -629,6 +709,13 @@ var Funcs = template.FuncMap{
 		}
 		return fmt.Sprint(v)
 	},
+	"toJSON": func(v interface{}) string {
+		bs, e := json.Marshal(v)
+		if e != nil {
+			return "null"
+		}
+		return string(bs)
+	},
 	"toInt": func(v interface{}, defaultValue ...int) int {
 		if len(defaultValue) > 0 {
 			return asIntWithDefault(v, defaultValue[0])
@@ -862,7 +949,7 @@ func genBody(prefix string, body, args interface{}) (interface{}, error) {
 			m[key] = a
 		}
 	default:
-		log.Printf("参数不正确，类型为 %T\r\n", body)
+		logger.Warn("参数不正确", "type", fmt.Sprintf("%T", body))
 	}
 	return body, nil
 }
@@ -1060,7 +1147,7 @@ func toKeyValues(txt string, headers map[string]interface{}) map[string]interfac
 		k := strings.TrimSpace(kvs[0])
 		v := strings.TrimSpace(kvs[1])
 		if k == "" || v == "" {
-			fmt.Println("请检一下，是不是换行了")
+			logger.Warn("请检一下，是不是换行了", "line", line)
 			continue
 		}
 
@@ -1,6 +1,7 @@
 package delayed_job
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -108,6 +109,10 @@ func newWeixinHandler(ctx, params map[string]interface{}) (Handler, error) {
 }
 
 func (self *weixinHandler) Perform() error {
+	return self.PerformContext(context.Background())
+}
+
+func (self *weixinHandler) PerformContext(ctx context.Context) error {
 	if IsDevEnv {
 		return ErrDevEnv
 	}
@@ -134,7 +139,7 @@ func (self *weixinHandler) Perform() error {
 	} else if "" != r.InvalidTag {
 		return errors.New("invalid tag - " + r.InvalidUser)
 	} else {
-		fmt.Println(fmt.Sprintf("%#v", r))
+		loggerFrom(ctx).Info("weixin message sent", "handler", "weixin", "result", fmt.Sprintf("%#v", r))
 	}
 	return nil
 }
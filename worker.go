@@ -2,19 +2,22 @@ package delayed_job
 
 import (
 	"bytes"
+	"context"
 	"database/sql"
-	"encoding/json"
 	"errors"
 	"expvar"
 	"flag"
 	"io"
-	"log"
+	"log/slog"
 	"os"
 	"strconv"
 	"strings"
 	"sync"
 	"testing"
 	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -68,6 +71,16 @@ type worker struct {
 	wait     sync.WaitGroup
 
 	closes []io.Closer
+
+	// 配置改变后通过 reload 通知 serve 重新读取， 见 configChanged
+	options map[string]interface{}
+	reload  chan struct{}
+	unwatch func()
+
+	// 暂停的队列， 见 pausedQueues
+	paused           []string
+	paused_loaded_at time.Time
+	paused_warned    bool
 }
 
 func newWorker(options map[string]interface{}) (*worker, error) {
@@ -93,14 +106,71 @@ func newWorker(options map[string]interface{}) (*worker, error) {
 		ctx:      ctx,
 		backend:  backend,
 		shutdown: make(chan int),
+		options:  options,
+		reload:   make(chan struct{}, 1),
 	}
 	w.initialize(options)
 
+	unwatchWorker := onConfigChanged(worker_flags, w.configChanged)
+	unwatchRedis := onConfigChanged([]string{"redis.address", "redis.password"}, func(values map[string]interface{}) error {
+		address, _ := values["redis.address"].(string)
+		password, _ := values["redis.password"].(string)
+		if "" == address {
+			return errors.New("redis.address is empty")
+		}
+		redis_client.reconnect(address, password)
+		return nil
+	})
+	w.unwatch = func() {
+		unwatchWorker()
+		unwatchRedis()
+	}
+
 	w.closes = append(w.closes, redis_client)
 	w.closes = append(w.closes, backend)
 	return w, nil
 }
 
+// worker_flags 是 worker 启动时读取的配置
+var worker_flags = []string{"min_priority",
+	"max_priority",
+	"max_attempts",
+	"max_run_time",
+	"sleep_delay",
+	"read_ahead",
+	"queues",
+	"exit_on_complete",
+	"destroy_failed_jobs"}
+
+// configChanged 检查新的配置， 并通知 serve 在执行任务的间隙通过 configValue 重新读取它们
+func (self *worker) configChanged(values map[string]interface{}) error {
+	if d, _ := values["sleep_delay"].(time.Duration); d <= 0 {
+		return errors.New("sleep_delay must is geater zero")
+	}
+	if d, _ := values["max_run_time"].(time.Duration); d <= 0 {
+		return errors.New("max_run_time must is geater zero")
+	}
+	if i, _ := values["max_attempts"].(int); i <= 0 {
+		return errors.New("max_attempts must is geater zero")
+	}
+	select {
+	case self.reload <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (self *worker) reloadOptions() {
+	self.initialize(self.options)
+	self.logger().Info("worker settings are reloaded",
+		"sleep_delay", self.sleep_delay,
+		"queues", self.queues,
+		"min_priority", self.min_priority,
+		"max_priority", self.max_priority,
+		"max_attempts", self.max_attempts,
+		"max_run_time", self.max_run_time)
+}
+
 func (w *worker) RunForever() {
 	w.serve(false)
 }
@@ -119,6 +189,9 @@ func (self *worker) Close() error {
 }
 
 func (self *worker) innerClose() {
+	if nil != self.unwatch {
+		self.unwatch()
+	}
 	if nil != self.closes {
 		for _, cl := range self.closes {
 			cl.Close()
@@ -127,20 +200,21 @@ func (self *worker) innerClose() {
 }
 
 func (self *worker) initialize(options map[string]interface{}) {
-	self.min_priority = intWithDefault(options, "min_priority", *default_min_priority)
-	self.max_priority = intWithDefault(options, "max_priority", *default_max_priority)
-	self.max_attempts = intWithDefault(options, "max_attempts", *default_max_attempts)
-	self.max_run_time = durationWithDefault(options, "max_run_time", *default_max_run_time)
-	self.sleep_delay = durationWithDefault(options, "sleep_delay", *default_sleep_delay)
-	self.read_ahead = intWithDefault(options, "read_ahead", *default_read_ahead)
-	if 0 == len(*default_queues) {
+	// 这些配置可以热加载， 要通过 configValue 读取
+	self.min_priority = intWithDefault(options, "min_priority", configInt("min_priority"))
+	self.max_priority = intWithDefault(options, "max_priority", configInt("max_priority"))
+	self.max_attempts = intWithDefault(options, "max_attempts", configInt("max_attempts"))
+	self.max_run_time = durationWithDefault(options, "max_run_time", configDuration("max_run_time"))
+	self.sleep_delay = durationWithDefault(options, "sleep_delay", configDuration("sleep_delay"))
+	self.read_ahead = intWithDefault(options, "read_ahead", configInt("read_ahead"))
+	if queues := configString("queues"); 0 == len(queues) {
 		self.queues = stringsWithDefault(options, "queues", ",", nil)
 	} else {
-		self.queues = stringsWithDefault(options, "queues", ",", strings.Split(*default_queues, ","))
+		self.queues = stringsWithDefault(options, "queues", ",", strings.Split(queues, ","))
 	}
 
-	self.exit_on_complete = boolWithDefault(options, "exit_on_complete", *default_exit_on_complete)
-	self.destroy_failed_jobs = boolWithDefault(options, "destroy_failed_jobs", *default_destroy_failed_jobs)
+	self.exit_on_complete = boolWithDefault(options, "exit_on_complete", configBool("exit_on_complete"))
+	self.destroy_failed_jobs = boolWithDefault(options, "destroy_failed_jobs", configBool("destroy_failed_jobs"))
 
 	// Every worker has a unique name which by default is the pid of the process. There are some
 	// advantages to overriding this with something which survives worker restarts:  Workers can
@@ -191,7 +265,7 @@ func (self *worker) serve(in_goroutine bool) {
 		defer self.wait.Done()
 	}
 
-	self.say("Starting job worker")
+	self.logger().Info("starting job worker")
 
 	//self.before_execute()
 	//defer self.after_execute()
@@ -199,22 +273,29 @@ func (self *worker) serve(in_goroutine bool) {
 	is_running := true
 	for is_running {
 		for is_running {
+			select {
+			case <-self.reload:
+				self.reloadOptions()
+			default:
+			}
+
 			now := time.Now()
 
 			success, failure, e := self.work_off(10)
 			if nil != e {
-				log.Println(e)
+				self.logger().Error("work off failed", "error", e)
 				work_error.Set(e.Error())
 				break
 			}
 
 			if 0 == success {
 				if self.exit_on_complete {
-					self.say("No more jobs available. Exiting")
+					self.logger().Info("no more jobs available, exiting")
 				}
 				break
 			} else {
-				self.say(success, "jobs processed at ", float64(success)/time.Now().Sub(now).Seconds(), " j/s, ", failure, " failed")
+				self.logger().Info("jobs processed", "success", success, "failure", failure,
+					"rate", float64(success)/time.Now().Sub(now).Seconds())
 			}
 
 			work_error.Set("")
@@ -223,6 +304,8 @@ func (self *worker) serve(in_goroutine bool) {
 		select {
 		case <-self.shutdown:
 			is_running = false
+		case <-self.reload:
+			self.reloadOptions()
 		case <-time.After(self.sleep_delay):
 		}
 	}
@@ -255,7 +338,9 @@ func (self *worker) work_off(num int) (int, int, error) {
 // Run the next job we can get an exclusive lock on.
 // If no jobs are left we return nil
 func (self *worker) reserve_and_run_one_job() (bool, error) {
+	started_at := time.Now()
 	job, e := self.backend.reserve(self)
+	metrics_reserve.ObserveDuration(time.Now().Sub(started_at))
 	if nil != e {
 		return false, e
 	}
@@ -264,16 +349,27 @@ func (self *worker) reserve_and_run_one_job() (bool, error) {
 		return false, jobs_is_empty
 	}
 
+	// 在取到任务后才知道它的跟踪上下文， 所以这里补一个 reserve 的 span
+	_, span := tracer.Start(job.traceContext(context.Background()), "reserve", jobSpanAttributes(job),
+		trace.WithTimestamp(started_at),
+		trace.WithAttributes(attribute.String("worker.name", self.name)))
+	span.End()
+	publishJobEvent(event_reserved, job, nil)
+
 	return self.run(job)
 }
 
 func (self *worker) run(job *Job) (bool, error) {
-	self.job_say(job, "RUNNING")
+	l := self.jobLogger(job)
+	l.Info("job running")
 	now := time.Now()
-	e := job.invokeJob()
+	e := job.invokeJobContext(withLogger(context.Background(), l))
+	elapsed := time.Now().Sub(now)
+	metrics_job_duration.ObserveDuration(elapsed, job.handlerType(), job.queue)
+	self.backend.recordAttempt(job, self.attemptStatus(job, e), self.name, elapsed, e)
 	if nil != e {
 		if isDeserializationError(e) {
-			self.job_say(job, "FAILED (", job.attempts, " prior attempts) with ", e)
+			l.Error("job failed", "duration", elapsed, "error", e)
 			e = self.failed(job, e)
 		} else {
 			e = self.handle_failed_job(job, e)
@@ -281,43 +377,53 @@ func (self *worker) run(job *Job) (bool, error) {
 		return false, e // work failed
 	}
 
+	metrics_jobs_succeeded.Inc(job.handlerType(), job.queue)
+	publishJobEvent(event_succeeded, job, nil)
+	if e = self.backend.enqueueCallbacks(job, callback_succeeded, nil); nil != e {
+		l.Warn("enqueue callback failed", "error", e)
+	}
 	if next_time, need := job.needReschedule(); need {
 		e = job.rescheduleIt(next_time, "")
 		return true, e
 	}
 
 	e = job.destroyIt()
-	self.job_say(job, "COMPLETED after ", time.Now().Sub(now))
+	l.Info("job completed", "duration", elapsed)
 	return true, e // did work
 }
 
+// attemptStatus 返回本次执行的结果， 要在 handle_failed_job 修改 attempts 之前调用
+func (self *worker) attemptStatus(job *Job, e error) string {
+	if nil == e {
+		return attempt_succeeded
+	}
+	if isDeserializationError(e) || job.attempts+1 > self.get_max_attempts(job) {
+		return attempt_failed
+	}
+	return attempt_retried
+}
+
 func (self *worker) failed(job *Job, e error) error {
+	metrics_jobs_failed.Inc(job.handlerType(), job.queue)
+	publishJobEvent(event_failed, job, e)
+	if err := self.backend.enqueueCallbacks(job, callback_failed, e); nil != err {
+		self.jobLogger(job).Warn("enqueue callback failed", "error", err)
+	}
 	if self.destroy_failed_jobs {
-		self.job_say(job, "REMOVED permanently because of attempts = ", job.attempts, "and max_attempts = ", self.get_max_attempts(job), " consecutive failures")
+		self.jobLogger(job).Warn("job removed permanently because of consecutive failures", "max_attempts", self.get_max_attempts(job))
 		return job.destroyIt()
 	} else {
-		self.job_say(job, "STOPPED permanently because of attempts = ", job.attempts, "and max_attempts = ", self.get_max_attempts(job), " consecutive failures")
+		self.jobLogger(job).Warn("job stopped permanently because of consecutive failures", "max_attempts", self.get_max_attempts(job))
 		return job.failIt(e.Error())
 	}
 }
 
-func (self *worker) job_say(job *Job, text ...interface{}) {
-	args := make([]interface{}, 0, 3+len(text))
-	if dump_job {
-		txt, _ := json.Marshal(job.handler_attributes)
-		args = append(args, "Job ", job.name(), string(txt))
-	} else {
-		args = append(args, "Job ", job.name(), " (id=", job.id, ") ")
-	}
-	args = append(args, text...)
-	self.say(args...)
+func (self *worker) jobLogger(job *Job) *slog.Logger {
+	return jobLogger(self.logger(), job)
 }
 
-func (self *worker) say(text ...interface{}) {
-	args := make([]interface{}, 0, 3+len(text))
-	args = append(args, "[Worker(", self.name, ")] ")
-	args = append(args, text...)
-	log.Println(args...)
+func (self *worker) logger() *slog.Logger {
+	return logger.With(slog.String("worker", self.name))
 }
 
 func (self *worker) get_max_attempts(job *Job) int {
@@ -329,7 +435,7 @@ func (self *worker) get_max_attempts(job *Job) int {
 }
 
 func (self *worker) handle_failed_job(job *Job, e error) error {
-	self.job_say(job, "FAILED (", job.attempts, " attempts and", self.get_max_attempts(job), " max_attempts) with ", e)
+	self.jobLogger(job).Warn("job failed", "max_attempts", self.get_max_attempts(job), "error", e)
 	return self.reschedule(job, time.Time{}, e)
 }
 
@@ -341,6 +447,8 @@ func (self *worker) reschedule(job *Job, next_time time.Time, e error) error {
 		if next_time.IsZero() {
 			next_time = job.reschedule_at()
 		}
+		metrics_jobs_retried.Inc(job.handlerType(), job.queue)
+		publishJobEvent(event_retried, job, e)
 		return job.rescheduleIt(next_time, e.Error())
 	} else {
 		return self.failed(job, e)
@@ -355,9 +463,10 @@ type TestWorker struct {
 
 func (self *TestWorker) WorkOff(num int) (int, int, error) {
 	dump_job = true
-	log.SetOutput(&self.Buffer)
+	old := logger
+	logger = slog.New(slog.NewTextHandler(&self.Buffer, &slog.HandlerOptions{ReplaceAttr: redactAttr}))
 	defer func() {
-		log.SetOutput(os.Stderr)
+		logger = old
 		dump_job = false
 	}()
 